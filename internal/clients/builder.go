@@ -29,6 +29,15 @@ type ClientBuilder struct {
 	SkipProviderRegistration    bool
 	StorageUseAzureAD           bool
 
+	StorageDataPlaneClientRequestID         string
+	StorageDefaultContainerMetaData         map[string]string
+	StorageMaxConcurrentDataPlaneOperations int
+	StorageUseResourceManagerForContainers  bool
+	StorageUserAgentSuffix                  string
+	StorageAllowLegacyContainerNames        bool
+
+	StorageAzureADRolePropagationRetrySeconds int
+
 	CustomCorrelationRequestID string
 	MetadataHost               string
 	PartnerID                  string
@@ -156,6 +165,15 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		SkipProviderReg:             builder.SkipProviderRegistration,
 		StorageUseAzureAD:           builder.StorageUseAzureAD,
 
+		StorageDataPlaneClientRequestID:         builder.StorageDataPlaneClientRequestID,
+		StorageDefaultContainerMetaData:         builder.StorageDefaultContainerMetaData,
+		StorageMaxConcurrentDataPlaneOperations: builder.StorageMaxConcurrentDataPlaneOperations,
+		StorageUseResourceManagerForContainers:  builder.StorageUseResourceManagerForContainers,
+		StorageUserAgentSuffix:                  builder.StorageUserAgentSuffix,
+		StorageAllowLegacyContainerNames:        builder.StorageAllowLegacyContainerNames,
+
+		StorageAzureADRolePropagationRetrySeconds: builder.StorageAzureADRolePropagationRetrySeconds,
+
 		// TODO: remove when `Azure/go-autorest` is no longer used
 		AzureEnvironment:        *azureEnvironment,
 		ResourceManagerEndpoint: *resourceManagerEndpoint,
@@ -51,6 +51,15 @@ type ClientOptions struct {
 	SkipProviderReg           bool
 	StorageUseAzureAD         bool
 
+	StorageDataPlaneClientRequestID         string
+	StorageDefaultContainerMetaData         map[string]string
+	StorageMaxConcurrentDataPlaneOperations int
+	StorageUseResourceManagerForContainers  bool
+	StorageUserAgentSuffix                  string
+	StorageAllowLegacyContainerNames        bool
+
+	StorageAzureADRolePropagationRetrySeconds int
+
 	// Keep these around for convenience with Autorest based clients, remove when we are no longer using autorest
 	AzureEnvironment        azure.Environment
 	ResourceManagerEndpoint string
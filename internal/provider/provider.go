@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
@@ -360,6 +361,62 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("ARM_STORAGE_USE_AZUREAD", false),
 				Description: "Should the AzureRM Provider use AzureAD to access the Storage Data Plane API's?",
 			},
+
+			"storage_max_concurrent_data_plane_operations": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_STORAGE_MAX_CONCURRENT_DATA_PLANE_OPERATIONS", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The maximum number of concurrent operations made against the Data Plane API's of a single Storage Account. Defaults to `0`, meaning unlimited.",
+			},
+
+			"storage_data_plane_client_request_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_STORAGE_DATA_PLANE_CLIENT_REQUEST_ID", ""),
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+				Description:  "The value sent in the `x-ms-client-request-id` header for requests made against the Storage Data Plane API's. When omitted a unique value is generated for each request.",
+			},
+
+			"storage_default_container_metadata": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				ValidateFunc: storageValidate.MetaDataKeys,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "MetaData which should be assigned to every Storage Container, merged with (and overridden by) the `metadata` specified on each `azurerm_storage_container`.",
+			},
+
+			"storage_use_resource_manager_for_containers": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_STORAGE_USE_RESOURCE_MANAGER_FOR_CONTAINERS", false),
+				Description: "Should the AzureRM Provider manage Storage Containers using the Resource Manager API, rather than the Storage Data Plane API's?",
+			},
+
+			"storage_user_agent_suffix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_STORAGE_USER_AGENT_SUFFIX", ""),
+				ValidateFunc: validation.StringLenBetween(0, 256),
+				Description:  "A value appended to the User Agent sent with requests made against the Storage Data Plane API's, which is recorded in the Storage Analytics Logs.",
+			},
+
+			"storage_allow_legacy_container_names": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_STORAGE_ALLOW_LEGACY_CONTAINER_NAMES", false),
+				Description: "Should existing Storage Containers whose names don't meet the current naming rules (for example containing uppercase characters) be able to be imported and managed? New Storage Containers must still use a valid name.",
+			},
+
+			"storage_azuread_role_propagation_retry_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_STORAGE_AZUREAD_ROLE_PROPAGATION_RETRY_SECONDS", 0),
+				ValidateFunc: validation.IntBetween(0, 3600),
+				Description:  "The number of seconds for which Storage Data Plane requests authorized using AzureAD are retried when these fail with an `AuthorizationPermissionMismatch`, since new Role Assignments can take several minutes to propagate. Defaults to `0`, meaning these aren't retried.",
+			},
 		},
 
 		DataSourcesMap: dataSources,
@@ -478,6 +535,15 @@ func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData
 		SubscriptionID:              d.Get("subscription_id").(string),
 		TerraformVersion:            p.TerraformVersion,
 
+		StorageDataPlaneClientRequestID:         d.Get("storage_data_plane_client_request_id").(string),
+		StorageDefaultContainerMetaData:         expandStorageDefaultContainerMetaData(d.Get("storage_default_container_metadata").(map[string]interface{})),
+		StorageMaxConcurrentDataPlaneOperations: d.Get("storage_max_concurrent_data_plane_operations").(int),
+		StorageUseResourceManagerForContainers:  d.Get("storage_use_resource_manager_for_containers").(bool),
+		StorageUserAgentSuffix:                  d.Get("storage_user_agent_suffix").(string),
+		StorageAllowLegacyContainerNames:        d.Get("storage_allow_legacy_container_names").(bool),
+
+		StorageAzureADRolePropagationRetrySeconds: d.Get("storage_azuread_role_propagation_retry_seconds").(int),
+
 		// this field is intentionally not exposed in the provider block, since it's only used for
 		// platform level tracing
 		CustomCorrelationRequestID: os.Getenv("ARM_CORRELATION_REQUEST_ID"),
@@ -649,3 +715,11 @@ More information on the "skip_provider_registration" flag can be found here:
 https://registry.terraform.io/providers/hashicorp/azurerm/latest/docs#skip_provider_registration
 
 Original Error: %s`
+
+func expandStorageDefaultContainerMetaData(input map[string]interface{}) map[string]string {
+	output := make(map[string]string)
+	for k, v := range input {
+		output[k] = v.(string)
+	}
+	return output
+}
@@ -154,6 +154,16 @@ func (sbu BlobUpload) uploadBlockBlob(ctx context.Context) error {
 	}
 	defer file.Close()
 
+	info, err := file.Stat()
+	if err != nil {
+		return fmt.Errorf("Could not stat file %q: %s", file.Name(), err)
+	}
+
+	// files larger than a single block are uploaded as multiple blocks concurrently, which are then committed
+	if fileSize := info.Size(); fileSize > maxBlockSize {
+		return sbu.blockUploadFromSource(ctx, file, fileSize)
+	}
+
 	input := blobs.PutBlockBlobInput{
 		ContentType: utils.String(sbu.ContentType),
 		MetaData:    sbu.MetaData,
@@ -383,6 +393,97 @@ func (sbu BlobUpload) blobPageUploadWorker(ctx context.Context, uploadCtx blobPa
 	}
 }
 
+const maxBlockSize int64 = 4 * 1024 * 1024
+
+type storageBlobBlock struct {
+	id      string
+	section *io.SectionReader
+}
+
+func (sbu BlobUpload) blockUploadFromSource(ctx context.Context, file io.ReaderAt, fileSize int64) error {
+	workerCount := sbu.Parallelism * runtime.NumCPU()
+
+	// first we chunk the file into 'blocks' - each of which needs an ID of the same length
+	blockList := make([]storageBlobBlock, 0)
+	blockIds := make([]blobs.BlockID, 0)
+	for offset := int64(0); offset < fileSize; offset += maxBlockSize {
+		length := maxBlockSize
+		if offset+length > fileSize {
+			length = fileSize - offset
+		}
+
+		id := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%032d", len(blockList))))
+		blockList = append(blockList, storageBlobBlock{
+			id:      id,
+			section: io.NewSectionReader(file, offset, length),
+		})
+		blockIds = append(blockIds, blobs.BlockID{
+			Value: id,
+		})
+	}
+
+	// then we upload each of these blocks
+	blocks := make(chan storageBlobBlock, len(blockList))
+	errors := make(chan error, len(blockList))
+	wg := &sync.WaitGroup{}
+	wg.Add(len(blockList))
+
+	for _, block := range blockList {
+		blocks <- block
+	}
+	close(blocks)
+
+	for i := 0; i < workerCount; i++ {
+		go sbu.blobBlockUploadWorker(ctx, blocks, errors, wg)
+	}
+
+	wg.Wait()
+
+	if len(errors) > 0 {
+		return fmt.Errorf("while uploading source file %q: %s", sbu.Source, <-errors)
+	}
+
+	// finally the blocks are committed, in order, to form the blob
+	input := blobs.PutBlockListInput{
+		BlockList: blobs.BlockList{
+			LatestBlockIDs: blockIds,
+		},
+		ContentType: utils.String(sbu.ContentType),
+		MetaData:    sbu.MetaData,
+	}
+	if sbu.ContentMD5 != "" {
+		input.ContentMD5 = utils.String(sbu.ContentMD5)
+	}
+	if _, err := sbu.Client.PutBlockList(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, input); err != nil {
+		return fmt.Errorf("PutBlockList: %s", err)
+	}
+
+	return nil
+}
+
+func (sbu BlobUpload) blobBlockUploadWorker(ctx context.Context, blocks chan storageBlobBlock, errors chan error, wg *sync.WaitGroup) {
+	for block := range blocks {
+		chunk := make([]byte, block.section.Size())
+		if _, err := block.section.Read(chunk); err != nil && err != io.EOF {
+			errors <- fmt.Errorf("reading block %q of source file %q: %s", block.id, sbu.Source, err)
+			wg.Done()
+			continue
+		}
+
+		input := blobs.PutBlockInput{
+			BlockID: block.id,
+			Content: chunk,
+		}
+		if _, err := sbu.Client.PutBlock(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, input); err != nil {
+			errors <- fmt.Errorf("writing block %q for file %q: %s", block.id, sbu.Source, err)
+			wg.Done()
+			continue
+		}
+
+		wg.Done()
+	}
+}
+
 func convertHexToBase64Encoding(str string) (string, error) {
 	data, err := hex.DecodeString(str)
 	if err != nil {
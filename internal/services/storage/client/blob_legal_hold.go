@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/autorest/validation"
+)
+
+// blobLegalHoldAPIVersion is the version of the Blob Service API used to manage the Legal Hold on a Blob, since
+// the Set Blob Legal Hold operation isn't available in the version of the API used by the Blobs Client
+const blobLegalHoldAPIVersion = "2020-10-02"
+
+// BlobLegalHoldClient manages the Legal Hold on a Blob (or a Version of a Blob), which requires version-level
+// immutability support to be enabled on the Storage Account or Container.
+type BlobLegalHoldClient struct {
+	dataPlaneClient
+}
+
+type GetBlobLegalHoldResult struct {
+	autorest.Response
+
+	LegalHold bool
+}
+
+func (client Client) BlobLegalHoldClient(ctx context.Context, account accountDetails) (*BlobLegalHoldClient, error) {
+	// the Blobs Client is reused so that this is authorized and configured in the same manner
+	blobsClient, err := client.BlobsClient(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BlobLegalHoldClient{
+		dataPlaneClient: newDataPlaneClient(blobsClient.Client, blobsClient.BaseURI),
+	}, nil
+}
+
+// GetLegalHold returns whether a Legal Hold is set on the specified Blob, or the specified Version of the
+// Blob when `versionId` is non-empty.
+func (client BlobLegalHoldClient) GetLegalHold(ctx context.Context, accountName, containerName, blobName, versionId string) (result GetBlobLegalHoldResult, err error) {
+	if err := validateBlobLegalHoldInput("GetLegalHold", accountName, containerName, blobName); err != nil {
+		return result, err
+	}
+
+	queryParameters := map[string]interface{}{}
+	if versionId != "" {
+		queryParameters["versionid"] = autorest.Encode("query", versionId)
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsHead(),
+		autorest.WithBaseURL(client.endpoint(accountName, "blob")),
+		autorest.WithPathParameters("/{containerName}/{blobName}", client.pathParameters(containerName, blobName)),
+		autorest.WithQueryParameters(queryParameters),
+		autorest.WithHeaders(map[string]interface{}{
+			"x-ms-version": blobLegalHoldAPIVersion,
+		}))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "client.BlobLegalHoldClient", "GetLegalHold", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := autorest.SendWithSender(client, req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "client.BlobLegalHoldClient", "GetLegalHold", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "client.BlobLegalHoldClient", "GetLegalHold", resp, "Failure responding to request")
+		return
+	}
+
+	// the header is omitted when a Legal Hold has never been set on the Blob
+	result.LegalHold = strings.EqualFold(resp.Header.Get("x-ms-legal-hold"), "true")
+	return
+}
+
+// SetLegalHold sets (or clears) the Legal Hold on the specified Blob, or the specified Version of the Blob
+// when `versionId` is non-empty.
+func (client BlobLegalHoldClient) SetLegalHold(ctx context.Context, accountName, containerName, blobName, versionId string, legalHold bool) (result autorest.Response, err error) {
+	if err := validateBlobLegalHoldInput("SetLegalHold", accountName, containerName, blobName); err != nil {
+		return result, err
+	}
+
+	queryParameters := map[string]interface{}{
+		"comp": autorest.Encode("query", "legalhold"),
+	}
+	if versionId != "" {
+		queryParameters["versionid"] = autorest.Encode("query", versionId)
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsPut(),
+		autorest.WithBaseURL(client.endpoint(accountName, "blob")),
+		autorest.WithPathParameters("/{containerName}/{blobName}", client.pathParameters(containerName, blobName)),
+		autorest.WithQueryParameters(queryParameters),
+		autorest.WithHeaders(map[string]interface{}{
+			"x-ms-version":    blobLegalHoldAPIVersion,
+			"x-ms-legal-hold": fmt.Sprintf("%t", legalHold),
+		}))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "client.BlobLegalHoldClient", "SetLegalHold", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := autorest.SendWithSender(client, req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		result = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "client.BlobLegalHoldClient", "SetLegalHold", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByClosing())
+	result = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "client.BlobLegalHoldClient", "SetLegalHold", resp, "Failure responding to request")
+	}
+	return
+}
+
+func (client BlobLegalHoldClient) pathParameters(containerName, blobName string) map[string]interface{} {
+	return map[string]interface{}{
+		"containerName": autorest.Encode("path", containerName),
+		"blobName":      autorest.Encode("path", blobName),
+	}
+}
+
+func validateBlobLegalHoldInput(operation, accountName, containerName, blobName string) error {
+	if accountName == "" {
+		return validation.NewError("client.BlobLegalHoldClient", operation, "`accountName` cannot be an empty string.")
+	}
+	if containerName == "" {
+		return validation.NewError("client.BlobLegalHoldClient", operation, "`containerName` cannot be an empty string.")
+	}
+	if strings.ToLower(containerName) != containerName {
+		return validation.NewError("client.BlobLegalHoldClient", operation, "`containerName` must be a lower-cased string.")
+	}
+	if blobName == "" {
+		return validation.NewError("client.BlobLegalHoldClient", operation, "`blobName` cannot be an empty string.")
+	}
+	return nil
+}
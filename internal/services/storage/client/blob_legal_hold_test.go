@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestBlobLegalHoldClientSetLegalHold(t *testing.T) {
+	testData := []struct {
+		name          string
+		versionId     string
+		legalHold     bool
+		expectedQuery string
+		expectedValue string
+	}{
+		{
+			name:          "set",
+			legalHold:     true,
+			expectedQuery: "comp=legalhold",
+			expectedValue: "true",
+		},
+		{
+			name:          "clear",
+			legalHold:     false,
+			expectedQuery: "comp=legalhold",
+			expectedValue: "false",
+		},
+		{
+			name:          "set on a version",
+			versionId:     "2023-01-01T00:00:00.0000000Z",
+			legalHold:     true,
+			expectedQuery: "comp=legalhold&versionid=2023-01-01T00%3A00%3A00.0000000Z",
+			expectedValue: "true",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		var actual *http.Request
+		client := BlobLegalHoldClient{
+			dataPlaneClient: testDataPlaneClient(func(r *http.Request) *http.Response {
+				actual = r
+				return &http.Response{
+					Request:    r,
+					StatusCode: http.StatusOK,
+					Header:     http.Header{},
+					Body:       io.NopCloser(strings.NewReader("")),
+				}
+			}),
+		}
+
+		if _, err := client.SetLegalHold(context.Background(), "example", "container", "folder/blob.txt", v.versionId, v.legalHold); err != nil {
+			t.Fatalf("setting legal hold: %+v", err)
+		}
+
+		if actual.Method != http.MethodPut {
+			t.Fatalf("expected the method to be %q but got %q", http.MethodPut, actual.Method)
+		}
+		if actual.URL.Host != "example.blob.core.windows.net" {
+			t.Fatalf("expected the host to be %q but got %q", "example.blob.core.windows.net", actual.URL.Host)
+		}
+		if actual.URL.Path != "/container/folder/blob.txt" {
+			t.Fatalf("expected the path to be %q but got %q", "/container/folder/blob.txt", actual.URL.Path)
+		}
+		if actual.URL.RawQuery != v.expectedQuery {
+			t.Fatalf("expected the query to be %q but got %q", v.expectedQuery, actual.URL.RawQuery)
+		}
+		if value := actual.Header.Get("x-ms-legal-hold"); value != v.expectedValue {
+			t.Fatalf("expected the legal hold header to be %q but got %q", v.expectedValue, value)
+		}
+		if version := actual.Header.Get("x-ms-version"); version != blobLegalHoldAPIVersion {
+			t.Fatalf("expected the version to be %q but got %q", blobLegalHoldAPIVersion, version)
+		}
+	}
+}
+
+func TestBlobLegalHoldClientGetLegalHold(t *testing.T) {
+	testData := []struct {
+		name     string
+		header   string
+		expected bool
+	}{
+		{
+			name:     "never set",
+			header:   "",
+			expected: false,
+		},
+		{
+			name:     "cleared",
+			header:   "false",
+			expected: false,
+		},
+		{
+			name:     "set",
+			header:   "true",
+			expected: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		client := BlobLegalHoldClient{
+			dataPlaneClient: testDataPlaneClient(func(r *http.Request) *http.Response {
+				resp := &http.Response{
+					Request:    r,
+					StatusCode: http.StatusOK,
+					Header:     http.Header{},
+					Body:       io.NopCloser(strings.NewReader("")),
+				}
+				if v.header != "" {
+					resp.Header.Set("x-ms-legal-hold", v.header)
+				}
+				return resp
+			}),
+		}
+
+		result, err := client.GetLegalHold(context.Background(), "example", "container", "blob.txt", "")
+		if err != nil {
+			t.Fatalf("retrieving legal hold: %+v", err)
+		}
+		if result.LegalHold != v.expected {
+			t.Fatalf("expected the legal hold to be %t but got %t", v.expected, result.LegalHold)
+		}
+	}
+}
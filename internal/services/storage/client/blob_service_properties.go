@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/autorest/validation"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/blob/accounts"
+)
+
+// BlobServicePropertiesClient sets the Delete Retention Policy for the Blob Service using the Data Plane API. This
+// is needed since the `Enabled` field within the Accounts Client's model is omitted when false, meaning that the
+// Delete Retention Policy can't otherwise be disabled.
+type BlobServicePropertiesClient struct {
+	dataPlaneClient
+}
+
+type blobServiceDeleteRetentionProperties struct {
+	XMLName               xml.Name                         `xml:"StorageServiceProperties"`
+	DeleteRetentionPolicy blobServiceDeleteRetentionPolicy `xml:"DeleteRetentionPolicy"`
+}
+
+type blobServiceDeleteRetentionPolicy struct {
+	Enabled bool `xml:"Enabled"`
+	Days    *int `xml:"Days,omitempty"`
+}
+
+func (client Client) BlobServicePropertiesClient(ctx context.Context, account accountDetails) (*BlobServicePropertiesClient, error) {
+	// the Accounts Data Plane Client is reused so that this is authorized and configured in the same manner
+	accountsClient, err := client.AccountsDataPlaneClient(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BlobServicePropertiesClient{
+		dataPlaneClient: newDataPlaneClient(accountsClient.Client, accountsClient.BaseURI),
+	}, nil
+}
+
+// SetDeleteRetentionPolicy enables the Delete Retention Policy for the Blob Service, retaining deleted Blobs for
+// the specified number of days - or disables it when `enabled` is false. The other Blob Service Properties are
+// omitted from the request, and so are left unchanged.
+func (client BlobServicePropertiesClient) SetDeleteRetentionPolicy(ctx context.Context, accountName string, enabled bool, days int) (result autorest.Response, err error) {
+	if accountName == "" {
+		return result, validation.NewError("client.BlobServicePropertiesClient", "SetDeleteRetentionPolicy", "`accountName` cannot be an empty string.")
+	}
+	if enabled && (days < 1 || days > 365) {
+		return result, validation.NewError("client.BlobServicePropertiesClient", "SetDeleteRetentionPolicy", "`days` must be between 1 and 365.")
+	}
+
+	input := blobServiceDeleteRetentionProperties{
+		DeleteRetentionPolicy: blobServiceDeleteRetentionPolicy{
+			Enabled: enabled,
+		},
+	}
+	if enabled {
+		input.DeleteRetentionPolicy.Days = &days
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsPut(),
+		autorest.WithBaseURL(client.endpoint(accountName, "blob")),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"restype": "service",
+			"comp":    "properties",
+		}),
+		autorest.WithHeaders(map[string]interface{}{
+			"x-ms-version": accounts.APIVersion,
+		}),
+		autorest.WithXML(input))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "client.BlobServicePropertiesClient", "SetDeleteRetentionPolicy", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := autorest.SendWithSender(client, req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		result = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "client.BlobServicePropertiesClient", "SetDeleteRetentionPolicy", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusAccepted),
+		autorest.ByClosing())
+	result = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "client.BlobServicePropertiesClient", "SetDeleteRetentionPolicy", resp, "Failure responding to request")
+	}
+	return
+}
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestBlobServicePropertiesClientSetDeleteRetentionPolicy(t *testing.T) {
+	testData := []struct {
+		name         string
+		enabled      bool
+		days         int
+		expectedBody string
+	}{
+		{
+			name:         "enabled",
+			enabled:      true,
+			days:         7,
+			expectedBody: "<StorageServiceProperties><DeleteRetentionPolicy><Enabled>true</Enabled><Days>7</Days></DeleteRetentionPolicy></StorageServiceProperties>",
+		},
+		{
+			// the SDK's model omits `Enabled` when false, which is why this client exists
+			name:         "disabled",
+			enabled:      false,
+			days:         7,
+			expectedBody: "<StorageServiceProperties><DeleteRetentionPolicy><Enabled>false</Enabled></DeleteRetentionPolicy></StorageServiceProperties>",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		var actual *http.Request
+		var actualBody string
+		client := BlobServicePropertiesClient{
+			dataPlaneClient: testDataPlaneClient(func(r *http.Request) *http.Response {
+				actual = r
+				body, _ := io.ReadAll(r.Body)
+				actualBody = string(body)
+				return &http.Response{
+					Request:    r,
+					StatusCode: http.StatusAccepted,
+					Header:     http.Header{},
+					Body:       io.NopCloser(strings.NewReader("")),
+				}
+			}),
+		}
+
+		if _, err := client.SetDeleteRetentionPolicy(context.Background(), "example", v.enabled, v.days); err != nil {
+			t.Fatalf("setting delete retention policy: %+v", err)
+		}
+
+		if actual.Method != http.MethodPut {
+			t.Fatalf("expected the method to be %q but got %q", http.MethodPut, actual.Method)
+		}
+		if actual.URL.Host != "example.blob.core.windows.net" {
+			t.Fatalf("expected the host to be %q but got %q", "example.blob.core.windows.net", actual.URL.Host)
+		}
+		if actual.URL.RawQuery != "comp=properties&restype=service" {
+			t.Fatalf("expected the query to be %q but got %q", "comp=properties&restype=service", actual.URL.RawQuery)
+		}
+		if actualBody = strings.TrimPrefix(actualBody, xml.Header); actualBody != v.expectedBody {
+			t.Fatalf("expected the body to be %q but got %q", v.expectedBody, actualBody)
+		}
+	}
+}
+
+func TestBlobServicePropertiesClientSetDeleteRetentionPolicyInvalidDays(t *testing.T) {
+	client := BlobServicePropertiesClient{
+		dataPlaneClient: testDataPlaneClient(func(r *http.Request) *http.Response {
+			t.Fatalf("expected no request to be sent")
+			return nil
+		}),
+	}
+
+	for _, days := range []int{0, 366} {
+		if _, err := client.SetDeleteRetentionPolicy(context.Background(), "example", true, days); err == nil {
+			t.Fatalf("expected an error for %d days but didn't get one", days)
+		}
+	}
+}
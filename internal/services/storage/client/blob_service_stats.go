@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/autorest/validation"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/blob/accounts"
+)
+
+// BlobServiceStatsClient retrieves the statistics for the Blob Service from the secondary location of a Storage
+// Account using the Data Plane API, since this operation isn't available in the Accounts Client.
+type BlobServiceStatsClient struct {
+	dataPlaneClient
+}
+
+type GetBlobServiceStatsResult struct {
+	autorest.Response
+
+	GeoReplication BlobServiceGeoReplication `xml:"GeoReplication"`
+}
+
+type BlobServiceGeoReplication struct {
+	// Status is the status of the secondary location, being one of `live`, `bootstrap` or `unavailable`
+	Status string `xml:"Status"`
+
+	// LastSyncTime is the time (in RFC1123 format) before which all primary writes are guaranteed to be available
+	// for read operations at the secondary location, which is empty when this isn't known
+	LastSyncTime string `xml:"LastSyncTime"`
+}
+
+func (client Client) BlobServiceStatsClient(ctx context.Context, account accountDetails) (*BlobServiceStatsClient, error) {
+	// the Accounts Data Plane Client is reused so that this is authorized and configured in the same manner
+	accountsClient, err := client.AccountsDataPlaneClient(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BlobServiceStatsClient{
+		dataPlaneClient: newDataPlaneClient(accountsClient.Client, accountsClient.BaseURI),
+	}, nil
+}
+
+// GetServiceStats returns the Geo-Replication statistics for the Blob Service. These are only available from the
+// secondary location, and so this requires read-access geo-redundant replication to be enabled for the Account.
+func (client BlobServiceStatsClient) GetServiceStats(ctx context.Context, accountName string) (result GetBlobServiceStatsResult, err error) {
+	if accountName == "" {
+		return result, validation.NewError("client.BlobServiceStatsClient", "GetServiceStats", "`accountName` cannot be an empty string.")
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(client.endpoint(accountName+"-secondary", "blob")),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"restype": "service",
+			"comp":    "stats",
+		}),
+		autorest.WithHeaders(map[string]interface{}{
+			"x-ms-version": accounts.APIVersion,
+		}))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "client.BlobServiceStatsClient", "GetServiceStats", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := autorest.SendWithSender(client, req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "client.BlobServiceStatsClient", "GetServiceStats", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingXML(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "client.BlobServiceStatsClient", "GetServiceStats", resp, "Failure responding to request")
+	}
+	return
+}
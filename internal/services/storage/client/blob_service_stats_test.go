@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestBlobServiceStatsClientGetServiceStats(t *testing.T) {
+	testData := []struct {
+		name     string
+		body     string
+		expected BlobServiceGeoReplication
+	}{
+		{
+			name: "live",
+			body: `<?xml version="1.0" encoding="utf-8"?><StorageServiceStats><GeoReplication><Status>live</Status><LastSyncTime>Wed, 19 Jul 2023 10:00:00 GMT</LastSyncTime></GeoReplication></StorageServiceStats>`,
+			expected: BlobServiceGeoReplication{
+				Status:       "live",
+				LastSyncTime: "Wed, 19 Jul 2023 10:00:00 GMT",
+			},
+		},
+		{
+			name: "bootstrap",
+			body: `<?xml version="1.0" encoding="utf-8"?><StorageServiceStats><GeoReplication><Status>bootstrap</Status><LastSyncTime /></GeoReplication></StorageServiceStats>`,
+			expected: BlobServiceGeoReplication{
+				Status: "bootstrap",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		var actual *http.Request
+		client := BlobServiceStatsClient{
+			dataPlaneClient: testDataPlaneClient(func(r *http.Request) *http.Response {
+				actual = r
+				return &http.Response{
+					Request:    r,
+					StatusCode: http.StatusOK,
+					Header:     http.Header{},
+					Body:       io.NopCloser(strings.NewReader(v.body)),
+				}
+			}),
+		}
+
+		result, err := client.GetServiceStats(context.Background(), "example")
+		if err != nil {
+			t.Fatalf("retrieving Service Stats: %+v", err)
+		}
+
+		if actual.Method != http.MethodGet {
+			t.Fatalf("expected the method to be %q but got %q", http.MethodGet, actual.Method)
+		}
+		if actual.URL.Host != "example-secondary.blob.core.windows.net" {
+			t.Fatalf("expected the host to be %q but got %q", "example-secondary.blob.core.windows.net", actual.URL.Host)
+		}
+		if query := actual.URL.Query(); query.Get("restype") != "service" || query.Get("comp") != "stats" {
+			t.Fatalf("expected the query to be %q but got %q", "comp=stats&restype=service", actual.URL.RawQuery)
+		}
+		if result.GeoReplication != v.expected {
+			t.Fatalf("expected %+v but got %+v", v.expected, result.GeoReplication)
+		}
+	}
+}
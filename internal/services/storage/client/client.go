@@ -6,6 +6,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-09-01/storage" // nolint: staticcheck
 	"github.com/Azure/go-autorest/autorest"
@@ -46,8 +47,17 @@ type Client struct {
 
 	ResourceManager *storage_v2023_01_01.Client
 
-	resourceManagerAuthorizer autorest.Authorizer
-	storageAdAuth             *autorest.Authorizer
+	resourceManagerAuthorizer        autorest.Authorizer
+	storageAdAuth                    *autorest.Authorizer
+	maxConcurrentDataPlaneOperations int
+	dataPlaneOperations              *dataPlaneOperationsSemaphores
+	dataPlaneClientRequestId         string
+	defaultContainerMetaData         map[string]string
+	useResourceManagerForContainers  bool
+	userAgentSuffix                  string
+	allowLegacyContainerNames        bool
+	rolePropagationRetryWindow       time.Duration
+	containersClients                *containersClientCache
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -56,9 +66,11 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 
 	fileSystemsClient := filesystems.NewWithEnvironment(o.AzureEnvironment)
 	o.ConfigureClient(&fileSystemsClient.Client, o.StorageAuthorizer)
+	fileSystemsClient.UserAgent = withUserAgentSuffix(fileSystemsClient.UserAgent, o.StorageUserAgentSuffix)
 
 	adlsGen2PathsClient := paths.NewWithEnvironment(o.AzureEnvironment)
 	o.ConfigureClient(&adlsGen2PathsClient.Client, o.StorageAuthorizer)
+	adlsGen2PathsClient.UserAgent = withUserAgentSuffix(adlsGen2PathsClient.UserAgent, o.StorageUserAgentSuffix)
 
 	blobServicesClient := storage.NewBlobServicesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&blobServicesClient.Client, o.ResourceManagerAuthorizer)
@@ -113,7 +125,16 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		SyncServiceClient:           syncServiceClient,
 		SyncGroupsClient:            syncGroupsClient,
 
-		resourceManagerAuthorizer: o.ResourceManagerAuthorizer,
+		resourceManagerAuthorizer:        o.ResourceManagerAuthorizer,
+		maxConcurrentDataPlaneOperations: o.StorageMaxConcurrentDataPlaneOperations,
+		dataPlaneOperations:              newDataPlaneOperationsSemaphores(),
+		dataPlaneClientRequestId:         o.StorageDataPlaneClientRequestID,
+		defaultContainerMetaData:         o.StorageDefaultContainerMetaData,
+		useResourceManagerForContainers:  o.StorageUseResourceManagerForContainers,
+		userAgentSuffix:                  o.StorageUserAgentSuffix,
+		allowLegacyContainerNames:        o.StorageAllowLegacyContainerNames,
+		rolePropagationRetryWindow:       time.Duration(o.StorageAzureADRolePropagationRetrySeconds) * time.Second,
+		containersClients:                newContainersClientCache(),
 	}
 
 	if o.StorageUseAzureAD {
@@ -123,10 +144,17 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	return &client, nil
 }
 
+// AllowLegacyContainerNames returns whether existing Containers whose names don't meet the current naming rules
+// can be managed, as configured using `storage_allow_legacy_container_names` on the Provider
+func (client Client) AllowLegacyContainerNames() bool {
+	return client.allowLegacyContainerNames
+}
+
 func (client Client) AccountsDataPlaneClient(ctx context.Context, account accountDetails) (*accounts.Client, error) {
 	if client.storageAdAuth != nil {
 		accountsClient := accounts.NewWithEnvironment(client.Environment)
 		accountsClient.Client.Authorizer = *client.storageAdAuth
+		client.configureDataPlaneClient(account, &accountsClient.Client)
 		return &accountsClient, nil
 	}
 
@@ -142,6 +170,7 @@ func (client Client) AccountsDataPlaneClient(ctx context.Context, account accoun
 
 	accountsClient := accounts.NewWithEnvironment(client.Environment)
 	accountsClient.Client.Authorizer = storageAuth
+	client.configureDataPlaneClient(account, &accountsClient.Client)
 	return &accountsClient, nil
 }
 
@@ -149,6 +178,7 @@ func (client Client) BlobsClient(ctx context.Context, account accountDetails) (*
 	if client.storageAdAuth != nil {
 		blobsClient := blobs.NewWithEnvironment(client.Environment)
 		blobsClient.Client.Authorizer = *client.storageAdAuth
+		client.configureDataPlaneClient(account, &blobsClient.Client)
 		return &blobsClient, nil
 	}
 
@@ -164,31 +194,45 @@ func (client Client) BlobsClient(ctx context.Context, account accountDetails) (*
 
 	blobsClient := blobs.NewWithEnvironment(client.Environment)
 	blobsClient.Client.Authorizer = storageAuth
+	client.configureDataPlaneClient(account, &blobsClient.Client)
 	return &blobsClient, nil
 }
 
 func (client Client) ContainersClient(ctx context.Context, account accountDetails) (shim.StorageContainerWrapper, error) {
-	if client.storageAdAuth != nil {
-		containersClient := containers.NewWithEnvironment(client.Environment)
-		containersClient.Client.Authorizer = *client.storageAdAuth
-		shim := shim.NewDataPlaneStorageContainerWrapper(&containersClient)
-		return shim, nil
+	// when `storage_use_resource_manager_for_containers` is enabled the Data Plane API isn't used at all, so
+	// neither the Account Key nor an Azure AD token for the Storage Account is required
+	if client.useResourceManagerForContainers {
+		return shim.NewResourceManagerStorageContainerWrapper(client.ResourceManager.BlobContainers, client.SubscriptionId), nil
 	}
 
-	accountKey, err := account.AccountKey(ctx, client)
-	if err != nil {
-		return nil, fmt.Errorf("retrieving Account Key: %s", err)
-	}
-
-	storageAuth, err := autorest.NewSharedKeyAuthorizer(account.name, *accountKey, autorest.SharedKey)
-	if err != nil {
-		return nil, fmt.Errorf("building Authorizer: %+v", err)
+	// the Containers Client is cached per Storage Account (for this instance of the Provider), so that reading many
+	// Containers within the same Storage Account (e.g. during a refresh) reuses the same Client and Authorizer
+	if existing, ok := client.containersClients.get(account.name); ok {
+		return existing, nil
 	}
 
 	containersClient := containers.NewWithEnvironment(client.Environment)
-	containersClient.Client.Authorizer = storageAuth
+	if client.storageAdAuth != nil {
+		containersClient.Client.Authorizer = *client.storageAdAuth
+		client.configureDataPlaneClient(account, &containersClient.Client)
+	} else {
+		accountKey, err := account.AccountKey(ctx, client)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving Account Key: %s", err)
+		}
+
+		storageAuth, err := autorest.NewSharedKeyAuthorizer(account.name, *accountKey, autorest.SharedKey)
+		if err != nil {
+			return nil, fmt.Errorf("building Authorizer: %+v", err)
+		}
+		containersClient.Client.Authorizer = storageAuth
+		client.configureDataPlaneClient(account, &containersClient.Client)
+	}
 
 	shim := shim.NewDataPlaneStorageContainerWrapper(&containersClient)
+
+	client.containersClients.set(account.name, shim)
+
 	return shim, nil
 }
 
@@ -207,6 +251,7 @@ func (client Client) FileShareDirectoriesClient(ctx context.Context, account acc
 
 	directoriesClient := directories.NewWithEnvironment(client.Environment)
 	directoriesClient.Client.Authorizer = storageAuth
+	client.configureDataPlaneClient(account, &directoriesClient.Client)
 	return &directoriesClient, nil
 }
 
@@ -225,6 +270,7 @@ func (client Client) FileShareFilesClient(ctx context.Context, account accountDe
 
 	filesClient := files.NewWithEnvironment(client.Environment)
 	filesClient.Client.Authorizer = storageAuth
+	client.configureDataPlaneClient(account, &filesClient.Client)
 	return &filesClient, nil
 }
 
@@ -243,15 +289,26 @@ func (client Client) FileSharesClient(ctx context.Context, account accountDetail
 
 	sharesClient := shares.NewWithEnvironment(client.Environment)
 	sharesClient.Client.Authorizer = storageAuth
+	client.configureDataPlaneClient(account, &sharesClient.Client)
 	shim := shim.NewDataPlaneStorageShareWrapper(&sharesClient)
 	return shim, nil
 }
 
 func (client Client) QueuesClient(ctx context.Context, account accountDetails) (shim.StorageQueuesWrapper, error) {
+	queuesClient, err := client.queuesDataPlaneClient(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	return shim.NewDataPlaneStorageQueueWrapper(queuesClient), nil
+}
+
+func (client Client) queuesDataPlaneClient(ctx context.Context, account accountDetails) (*queues.Client, error) {
 	if client.storageAdAuth != nil {
 		queueClient := queues.NewWithEnvironment(client.Environment)
 		queueClient.Client.Authorizer = *client.storageAdAuth
-		return shim.NewDataPlaneStorageQueueWrapper(&queueClient), nil
+		client.configureDataPlaneClient(account, &queueClient.Client)
+		return &queueClient, nil
 	}
 
 	accountKey, err := account.AccountKey(ctx, client)
@@ -266,7 +323,8 @@ func (client Client) QueuesClient(ctx context.Context, account accountDetails) (
 
 	queuesClient := queues.NewWithEnvironment(client.Environment)
 	queuesClient.Client.Authorizer = storageAuth
-	return shim.NewDataPlaneStorageQueueWrapper(&queuesClient), nil
+	client.configureDataPlaneClient(account, &queuesClient.Client)
+	return &queuesClient, nil
 }
 
 func (client Client) TableEntityClient(ctx context.Context, account accountDetails) (*entities.Client, error) {
@@ -284,10 +342,24 @@ func (client Client) TableEntityClient(ctx context.Context, account accountDetai
 
 	entitiesClient := entities.NewWithEnvironment(client.Environment)
 	entitiesClient.Client.Authorizer = storageAuth
+	client.configureDataPlaneClient(account, &entitiesClient.Client)
 	return &entitiesClient, nil
 }
 
 func (client Client) TablesClient(ctx context.Context, account accountDetails) (shim.StorageTableWrapper, error) {
+	tablesClient, err := client.tablesDataPlaneClient(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	aclClient := &TableACLClient{
+		dataPlaneClient: newDataPlaneClient(tablesClient.Client, tablesClient.BaseURI),
+	}
+	shim := shim.NewDataPlaneStorageTableWrapper(tablesClient, aclClient)
+	return shim, nil
+}
+
+func (client Client) tablesDataPlaneClient(ctx context.Context, account accountDetails) (*tables.Client, error) {
 	// NOTE: Tables do not support AzureAD Authentication
 
 	accountKey, err := account.AccountKey(ctx, client)
@@ -302,6 +374,6 @@ func (client Client) TablesClient(ctx context.Context, account accountDetails) (
 
 	tablesClient := tables.NewWithEnvironment(client.Environment)
 	tablesClient.Client.Authorizer = storageAuth
-	shim := shim.NewDataPlaneStorageTableWrapper(&tablesClient)
-	return shim, nil
+	client.configureDataPlaneClient(account, &tablesClient.Client)
+	return &tablesClient, nil
 }
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// limitDataPlaneOperations caps the number of concurrent Data Plane operations made against the specified
+// Storage Account across all Data Plane clients, such that requests beyond this limit are queued until an
+// in-flight request completes, rather than overwhelming the Storage Account (and being throttled)
+func (client Client) limitDataPlaneOperations(accountName string, c *autorest.Client) {
+	if client.maxConcurrentDataPlaneOperations <= 0 {
+		return
+	}
+
+	c.Sender = concurrencyLimitedSender{
+		semaphore: client.dataPlaneOperations.semaphore(accountName, client.maxConcurrentDataPlaneOperations),
+		sender:    c.Sender,
+	}
+}
+
+// dataPlaneOperationsSemaphores holds the semaphore limiting the concurrent Data Plane operations for each Storage
+// Account. This is held by each Client, since each instance of the Provider (e.g. an aliased Provider) can configure
+// a different `storage_max_concurrent_data_plane_operations`.
+type dataPlaneOperationsSemaphores struct {
+	lock       sync.Mutex
+	semaphores map[string]chan struct{}
+}
+
+func newDataPlaneOperationsSemaphores() *dataPlaneOperationsSemaphores {
+	return &dataPlaneOperationsSemaphores{
+		semaphores: map[string]chan struct{}{},
+	}
+}
+
+func (s *dataPlaneOperationsSemaphores) semaphore(accountName string, limit int) chan struct{} {
+	if s == nil {
+		return make(chan struct{}, limit)
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if semaphore, ok := s.semaphores[accountName]; ok {
+		return semaphore
+	}
+
+	semaphore := make(chan struct{}, limit)
+	s.semaphores[accountName] = semaphore
+	return semaphore
+}
+
+type concurrencyLimitedSender struct {
+	semaphore chan struct{}
+	sender    autorest.Sender
+}
+
+func (s concurrencyLimitedSender) Do(r *http.Request) (*http.Response, error) {
+	select {
+	case s.semaphore <- struct{}{}:
+	case <-r.Context().Done():
+		return nil, r.Context().Err()
+	}
+	defer func() {
+		<-s.semaphore
+	}()
+
+	return s.sender.Do(r)
+}
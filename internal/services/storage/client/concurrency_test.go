@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+type trackingSender struct {
+	current int32
+	maximum int32
+}
+
+func (s *trackingSender) Do(r *http.Request) (*http.Response, error) {
+	current := atomic.AddInt32(&s.current, 1)
+	for {
+		maximum := atomic.LoadInt32(&s.maximum)
+		if current <= maximum || atomic.CompareAndSwapInt32(&s.maximum, maximum, current) {
+			break
+		}
+	}
+
+	time.Sleep(10 * time.Millisecond)
+	atomic.AddInt32(&s.current, -1)
+
+	return &http.Response{StatusCode: http.StatusOK}, nil
+}
+
+func TestLimitDataPlaneOperations(t *testing.T) {
+	for _, limit := range []int{1, 3, 5} {
+		t.Logf("[DEBUG] Testing a limit of %d", limit)
+
+		sender := &trackingSender{}
+		client := Client{
+			maxConcurrentDataPlaneOperations: limit,
+			dataPlaneOperations:              newDataPlaneOperationsSemaphores(),
+		}
+
+		// two Data Plane clients for the same Storage Account should share the same limit
+		first := autorest.Client{Sender: sender}
+		client.limitDataPlaneOperations("limitaccount", &first)
+		second := autorest.Client{Sender: sender}
+		client.limitDataPlaneOperations("limitaccount", &second)
+
+		wg := sync.WaitGroup{}
+		for i := 0; i < 20; i++ {
+			c := first
+			if i%2 == 0 {
+				c = second
+			}
+
+			wg.Add(1)
+			go func(c autorest.Client) {
+				defer wg.Done()
+				req, _ := http.NewRequest(http.MethodGet, "https://limitaccount.blob.core.windows.net", nil)
+				if _, err := c.Sender.Do(req); err != nil {
+					t.Errorf("sending request: %+v", err)
+				}
+			}(c)
+		}
+		wg.Wait()
+
+		if sender.maximum > int32(limit) {
+			t.Fatalf("expected at most %d concurrent operations but got %d", limit, sender.maximum)
+		}
+	}
+}
+
+func TestLimitDataPlaneOperationsIsPerClient(t *testing.T) {
+	// each instance of the Provider (e.g. an aliased Provider) can configure a different limit for the same Account
+	clients := []Client{
+		{
+			maxConcurrentDataPlaneOperations: 1,
+			dataPlaneOperations:              newDataPlaneOperationsSemaphores(),
+		},
+		{
+			maxConcurrentDataPlaneOperations: 3,
+			dataPlaneOperations:              newDataPlaneOperationsSemaphores(),
+		},
+	}
+
+	senders := []*trackingSender{{}, {}}
+	dataPlaneClients := make([]autorest.Client, 0)
+	for i := 0; i < 4; i++ {
+		// the Data Plane clients for each Client are built in turn, so that each build could replace the other's limit
+		c := autorest.Client{Sender: senders[i%2]}
+		clients[i%2].limitDataPlaneOperations("limitaccount", &c)
+		dataPlaneClients = append(dataPlaneClients, c)
+	}
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 40; i++ {
+		wg.Add(1)
+		go func(c autorest.Client) {
+			defer wg.Done()
+			req, _ := http.NewRequest(http.MethodGet, "https://limitaccount.blob.core.windows.net", nil)
+			if _, err := c.Sender.Do(req); err != nil {
+				t.Errorf("sending request: %+v", err)
+			}
+		}(dataPlaneClients[i%len(dataPlaneClients)])
+	}
+	wg.Wait()
+
+	for i, client := range clients {
+		if senders[i].maximum > int32(client.maxConcurrentDataPlaneOperations) {
+			t.Fatalf("expected at most %d concurrent operations for Client %d but got %d", client.maxConcurrentDataPlaneOperations, i, senders[i].maximum)
+		}
+		if actual := cap(client.dataPlaneOperations.semaphore("limitaccount", client.maxConcurrentDataPlaneOperations)); actual != client.maxConcurrentDataPlaneOperations {
+			t.Fatalf("expected the limit for Client %d to be %d but got %d", i, client.maxConcurrentDataPlaneOperations, actual)
+		}
+	}
+}
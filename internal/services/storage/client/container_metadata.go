@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+// WithDefaultContainerMetaData returns the MetaData which should be assigned to a Container, being the
+// `storage_default_container_metadata` configured on the Provider merged with the specified MetaData -
+// where a key is present in both the value specified for the Container wins
+func (client Client) WithDefaultContainerMetaData(metaData map[string]string) map[string]string {
+	output := make(map[string]string)
+	for k, v := range client.defaultContainerMetaData {
+		output[k] = v
+	}
+	for k, v := range metaData {
+		output[k] = v
+	}
+	return output
+}
+
+// WithoutDefaultContainerMetaData returns the MetaData assigned to a Container, excluding any keys assigned
+// from the `storage_default_container_metadata` configured on the Provider (that is, keys which have the
+// default value and aren't specified in the Container's configuration) so these don't show as a diff
+func (client Client) WithoutDefaultContainerMetaData(metaData map[string]string, configured map[string]string) map[string]string {
+	output := make(map[string]string)
+	for k, v := range metaData {
+		if defaultValue, isDefault := client.defaultContainerMetaData[k]; isDefault && defaultValue == v {
+			if _, isConfigured := configured[k]; !isConfigured {
+				continue
+			}
+		}
+		output[k] = v
+	}
+	return output
+}
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWithDefaultContainerMetaData(t *testing.T) {
+	testData := []struct {
+		name     string
+		defaults map[string]string
+		input    map[string]string
+		expected map[string]string
+	}{
+		{
+			name:     "no defaults",
+			defaults: nil,
+			input:    map[string]string{"hello": "world"},
+			expected: map[string]string{"hello": "world"},
+		},
+		{
+			name:     "defaults only",
+			defaults: map[string]string{"owner": "platform"},
+			input:    map[string]string{},
+			expected: map[string]string{"owner": "platform"},
+		},
+		{
+			name:     "merged",
+			defaults: map[string]string{"owner": "platform"},
+			input:    map[string]string{"hello": "world"},
+			expected: map[string]string{"hello": "world", "owner": "platform"},
+		},
+		{
+			name:     "container value wins",
+			defaults: map[string]string{"owner": "platform"},
+			input:    map[string]string{"owner": "data"},
+			expected: map[string]string{"owner": "data"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		client := Client{defaultContainerMetaData: v.defaults}
+		actual := client.WithDefaultContainerMetaData(v.input)
+		if !reflect.DeepEqual(actual, v.expected) {
+			t.Fatalf("expected %+v but got %+v", v.expected, actual)
+		}
+	}
+}
+
+func TestWithoutDefaultContainerMetaData(t *testing.T) {
+	testData := []struct {
+		name       string
+		defaults   map[string]string
+		input      map[string]string
+		configured map[string]string
+		expected   map[string]string
+	}{
+		{
+			name:       "no defaults",
+			defaults:   nil,
+			input:      map[string]string{"hello": "world"},
+			configured: map[string]string{"hello": "world"},
+			expected:   map[string]string{"hello": "world"},
+		},
+		{
+			name:       "default removed",
+			defaults:   map[string]string{"owner": "platform"},
+			input:      map[string]string{"hello": "world", "owner": "platform"},
+			configured: map[string]string{"hello": "world"},
+			expected:   map[string]string{"hello": "world"},
+		},
+		{
+			name:       "default which is also configured is kept",
+			defaults:   map[string]string{"owner": "platform"},
+			input:      map[string]string{"owner": "platform"},
+			configured: map[string]string{"owner": "platform"},
+			expected:   map[string]string{"owner": "platform"},
+		},
+		{
+			name:       "default key with a different value is kept",
+			defaults:   map[string]string{"owner": "platform"},
+			input:      map[string]string{"owner": "someone-else"},
+			configured: map[string]string{},
+			expected:   map[string]string{"owner": "someone-else"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		client := Client{defaultContainerMetaData: v.defaults}
+		actual := client.WithoutDefaultContainerMetaData(v.input, v.configured)
+		if !reflect.DeepEqual(actual, v.expected) {
+			t.Fatalf("expected %+v but got %+v", v.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,260 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"bytes"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-09-01/storage" // nolint: staticcheck
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-uuid"
+)
+
+// HeaderClientRequestID is the header used to correlate Data Plane requests with the Storage Analytics Logs
+const HeaderClientRequestID = "x-ms-client-request-id"
+
+// configureDataPlaneClient configures the Sender used by a Data Plane client for the specified Storage Account
+func (client Client) configureDataPlaneClient(account accountDetails, c *autorest.Client) {
+	hosts := account.dataPlaneHosts(client.Environment.StorageEndpointSuffix)
+	c.RequestInspector = func(p autorest.Preparer) autorest.Preparer {
+		return withDataPlaneHosts(hosts)(withColdAccessTierAPIVersion()(withClientRequestID(client.dataPlaneClientRequestId)(p)))
+	}
+	if err := validateMinimumTLSVersion(account, c.Sender); err != nil {
+		c.Sender = autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			return nil, err
+		})
+	}
+	c.Sender = authenticationFailedSender{
+		sender: c.Sender,
+	}
+	c.UserAgent = withUserAgentSuffix(c.UserAgent, client.userAgentSuffix)
+	client.limitDataPlaneOperations(account.name, c)
+	client.retryDuringRolePropagation(c)
+}
+
+// validateMinimumTLSVersion returns an error when the Transport used by the Sender can't negotiate the minimum TLS
+// version required by the Storage Account - which otherwise fails during the TLS handshake with an error that doesn't
+// mention the TLS version. Senders which don't use an `http.Transport` can't be inspected, so are assumed to be fine.
+func validateMinimumTLSVersion(account accountDetails, sender autorest.Sender) error {
+	if account.Properties == nil || account.Properties.MinimumTLSVersion == "" {
+		return nil
+	}
+
+	required, ok := map[storage.MinimumTLSVersion]uint16{
+		storage.MinimumTLSVersionTLS10: tls.VersionTLS10,
+		storage.MinimumTLSVersionTLS11: tls.VersionTLS11,
+		storage.MinimumTLSVersionTLS12: tls.VersionTLS12,
+	}[account.Properties.MinimumTLSVersion]
+	if !ok {
+		return nil
+	}
+
+	httpClient, ok := sender.(*http.Client)
+	if !ok || httpClient == nil {
+		return nil
+	}
+	transport, ok := httpClient.Transport.(*http.Transport)
+	if !ok || transport == nil || transport.TLSClientConfig == nil || transport.TLSClientConfig.MaxVersion == 0 {
+		// when no maximum version is specified the highest version supported by Go is used
+		return nil
+	}
+
+	if maximum := transport.TLSClientConfig.MaxVersion; maximum < required {
+		return fmt.Errorf("storage account %q requires a minimum TLS version of %s (`min_tls_version`), however the HTTP transport used for data plane requests only supports up to %s - as such requests made to this storage account would fail during the TLS handshake", account.name, tls.VersionName(required), tls.VersionName(maximum))
+	}
+
+	return nil
+}
+
+// dataPlaneHosts returns the hosts of the Data Plane endpoints for this Storage Account which differ from the hosts
+// built by the Data Plane clients (`{accountName}.{endpointType}.{domainSuffix}`), keyed by the host which is built.
+// This is the case for Storage Accounts using an Azure DNS Zone endpoint, where the host is in the format
+// `{accountName}.{dnsZone}.{endpointType}.{domainSuffix}` - and so can't be built from the Domain Suffix alone.
+func (ad accountDetails) dataPlaneHosts(domainSuffix string) map[string]string {
+	hosts := make(map[string]string)
+	if ad.Properties == nil {
+		return hosts
+	}
+
+	for _, v := range []struct {
+		accountName string
+		endpoints   *storage.Endpoints
+	}{
+		{ad.name, ad.Properties.PrimaryEndpoints},
+		{fmt.Sprintf("%s-secondary", ad.name), ad.Properties.SecondaryEndpoints},
+	} {
+		if v.endpoints == nil {
+			continue
+		}
+
+		for endpointType, endpoint := range map[EndpointType]*string{
+			EndpointTypeBlob:  v.endpoints.Blob,
+			EndpointTypeDfs:   v.endpoints.Dfs,
+			EndpointTypeFile:  v.endpoints.File,
+			EndpointTypeQueue: v.endpoints.Queue,
+			EndpointTypeTable: v.endpoints.Table,
+			EndpointTypeWeb:   v.endpoints.Web,
+		} {
+			if endpoint == nil || *endpoint == "" {
+				continue
+			}
+			uri, err := url.Parse(*endpoint)
+			if err != nil || uri.Host == "" {
+				continue
+			}
+
+			built := strings.ToLower(fmt.Sprintf("%s.%s.%s", v.accountName, endpointType, domainSuffix))
+			if actual := strings.ToLower(uri.Host); actual != built {
+				hosts[built] = actual
+			}
+		}
+	}
+
+	return hosts
+}
+
+// withDataPlaneHosts sends requests to the Data Plane endpoints reported for the Storage Account, rather than to the
+// host built by the Data Plane client, where these differ (see dataPlaneHosts)
+func withDataPlaneHosts(hosts map[string]string) autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			if r.URL != nil {
+				if host, ok := hosts[strings.ToLower(r.URL.Host)]; ok {
+					r.URL.Host = host
+					r.Host = ""
+				}
+			}
+			return p.Prepare(r)
+		})
+	}
+}
+
+// withUserAgentSuffix appends the `storage_user_agent_suffix` specified in the Provider block to the User Agent used
+// for Data Plane requests, allowing these to be identified within the Storage Analytics Logs
+func withUserAgentSuffix(userAgent, suffix string) string {
+	suffix = strings.TrimSpace(suffix)
+	if suffix == "" {
+		return userAgent
+	}
+
+	return strings.TrimSpace(fmt.Sprintf("%s %s", userAgent, suffix))
+}
+
+// DataPlaneOperationNotSupported returns whether the error returned from a Data Plane operation indicates that the
+// operation isn't supported for this kind of Storage Account - for example ACL's on a Table within a Premium
+// Storage Account, for which the Storage Service returns a 501 NotImplemented
+func DataPlaneOperationNotSupported(err error) bool {
+	detailed, ok := err.(autorest.DetailedError)
+	if !ok || detailed.Response == nil {
+		return false
+	}
+
+	if detailed.Response.StatusCode == http.StatusNotImplemented {
+		return true
+	}
+
+	errorCode := detailed.Response.Header.Get("x-ms-error-code")
+	return strings.EqualFold(errorCode, "NotImplemented") || strings.Contains(strings.ToLower(errorCode), "notsupported")
+}
+
+// authenticationFailedSender surfaces a more helpful error when the signature for a Shared Key authorized request
+// doesn't match the signature computed by the Storage Account - which, whilst it looks like a credential issue, is
+// more commonly caused by clock skew on the machine running Terraform, or by the Storage Account Key being rotated
+type authenticationFailedSender struct {
+	sender autorest.Sender
+}
+
+func (s authenticationFailedSender) Do(r *http.Request) (*http.Response, error) {
+	resp, err := s.sender.Do(r)
+	if err != nil || resp == nil || resp.StatusCode != http.StatusForbidden {
+		return resp, err
+	}
+
+	if !strings.EqualFold(resp.Header.Get("x-ms-error-code"), "AuthenticationFailed") || resp.Body == nil {
+		return resp, err
+	}
+
+	body, readErr := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if readErr != nil || !strings.Contains(strings.ToLower(string(body)), "signature") {
+		return resp, err
+	}
+
+	return resp, fmt.Errorf("the Storage Account returned an AuthenticationFailed error since the signature for this request didn't match the signature computed by the Storage Account. This is commonly caused by the clock on the machine running Terraform being out of sync (requests must be made within 15 minutes of the Storage Service time), or by the Storage Account Key having been rotated since it was retrieved - rather than by an issue with the credentials. The response was: %s", strings.TrimSpace(string(body)))
+}
+
+// withClientRequestID sets the `x-ms-client-request-id` header, which is recorded in the Storage Analytics Logs,
+// allowing operations performed by Terraform to be correlated with those logs. When no value has been specified
+// a unique value is generated for each request. This is logged since it isn't otherwise surfaced.
+func withClientRequestID(id string) autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			requestId := id
+			if requestId == "" {
+				generated, err := uuid.GenerateUUID()
+				if err != nil {
+					return r, fmt.Errorf("generating Client Request ID: %+v", err)
+				}
+				requestId = generated
+			}
+			r.Header.Set(HeaderClientRequestID, requestId)
+			log.Printf("[DEBUG] Storage Data Plane request %s %s using Client Request ID %q", r.Method, r.URL.Redacted(), requestId)
+			return p.Prepare(r)
+		})
+	}
+}
+
+// coldAccessTierAPIVersion is the first version of the Storage API which supports the `Cold` Access Tier
+const coldAccessTierAPIVersion = "2021-12-02"
+
+// withColdAccessTierAPIVersion sends requests setting the `Cold` Access Tier using an API Version which supports it,
+// since the Data Plane clients use an earlier API Version - the Set Blob Tier operation is otherwise unchanged between
+// these. NOTE: this must be applied before the request is authorized, since the API Version is part of the signature.
+func withColdAccessTierAPIVersion() autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			if strings.EqualFold(r.Header.Get("x-ms-access-tier"), "Cold") && r.Header.Get("x-ms-version") < coldAccessTierAPIVersion {
+				r.Header.Set("x-ms-version", coldAccessTierAPIVersion)
+			}
+			return p.Prepare(r)
+		})
+	}
+}
+
+const (
+	AuthenticationMethodAzureAD   = "aad"
+	AuthenticationMethodSharedKey = "shared_key"
+
+	// AuthenticationMethodResourceManager is used when Storage Containers are managed using the Resource Manager
+	// API (`storage_use_resource_manager_for_containers`) rather than the Data Plane API
+	AuthenticationMethodResourceManager = "resource_manager"
+)
+
+// DataPlaneAuthenticationMethod returns the method used to authenticate against the Data Plane API's which support
+// authenticating using Azure AD (Blobs, Containers and Queues) - which is `aad` when `storage_use_azuread` is enabled
+func (client Client) DataPlaneAuthenticationMethod() string {
+	if client.storageAdAuth != nil {
+		return AuthenticationMethodAzureAD
+	}
+
+	return AuthenticationMethodSharedKey
+}
+
+// ContainersAuthenticationMethod returns the method used to authenticate when managing Storage Containers, which
+// is `resource_manager` when `storage_use_resource_manager_for_containers` is enabled
+func (client Client) ContainersAuthenticationMethod() string {
+	if client.useResourceManagerForContainers {
+		return AuthenticationMethodResourceManager
+	}
+
+	return client.DataPlaneAuthenticationMethod()
+}
@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"fmt"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// dataPlaneClient is embedded in the clients within this package which send Data Plane requests that aren't
+// available in the Giovanni SDK. Each of these is built from an existing Giovanni client, so that the requests are
+// authorized, retried and sent to the same endpoints in the same way.
+type dataPlaneClient struct {
+	autorest.Client
+	BaseURI string
+}
+
+func newDataPlaneClient(client autorest.Client, baseUri string) dataPlaneClient {
+	return dataPlaneClient{
+		Client:  client,
+		BaseURI: baseUri,
+	}
+}
+
+// endpoint returns the endpoint for the specified service (e.g. `blob` or `table`) within the Storage Account
+func (client dataPlaneClient) endpoint(accountName, service string) string {
+	return fmt.Sprintf("https://%s.%s.%s", accountName, service, client.BaseURI)
+}
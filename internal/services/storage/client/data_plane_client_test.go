@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// testDataPlaneClient returns a Data Plane client which passes each request to the handler, rather than sending it
+func testDataPlaneClient(handler func(r *http.Request) *http.Response) dataPlaneClient {
+	client := newDataPlaneClient(autorest.NewClientWithUserAgent("testing"), "core.windows.net")
+	client.RetryAttempts = 1
+	client.RetryDuration = 0
+	client.Sender = autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		return handler(r), nil
+	})
+	return client
+}
+
+func TestDataPlaneClientEndpoint(t *testing.T) {
+	client := testDataPlaneClient(func(r *http.Request) *http.Response {
+		t.Fatalf("expected no request to be sent")
+		return nil
+	})
+
+	if actual := client.endpoint("example", "queue"); actual != "https://example.queue.core.windows.net" {
+		t.Fatalf("expected the endpoint to be %q but got %q", "https://example.queue.core.windows.net", actual)
+	}
+	if actual := client.endpoint("example-secondary", "blob"); actual != "https://example-secondary.blob.core.windows.net" {
+		t.Fatalf("expected the endpoint to be %q but got %q", "https://example-secondary.blob.core.windows.net", actual)
+	}
+}
@@ -0,0 +1,496 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-09-01/storage" // nolint: staticcheck
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/table/entities"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/table/tables"
+)
+
+func TestAuthenticationFailedSender(t *testing.T) {
+	testData := []struct {
+		name        string
+		statusCode  int
+		errorCode   string
+		body        string
+		expectError bool
+	}{
+		{
+			name:        "success",
+			statusCode:  http.StatusOK,
+			body:        "",
+			expectError: false,
+		},
+		{
+			name:        "forbidden due to permissions",
+			statusCode:  http.StatusForbidden,
+			errorCode:   "AuthorizationPermissionMismatch",
+			body:        `<?xml version="1.0" encoding="utf-8"?><Error><Code>AuthorizationPermissionMismatch</Code><Message>This request is not authorized to perform this operation using this permission.</Message></Error>`,
+			expectError: false,
+		},
+		{
+			name:        "authentication failed without a signature mismatch",
+			statusCode:  http.StatusForbidden,
+			errorCode:   "AuthenticationFailed",
+			body:        `<?xml version="1.0" encoding="utf-8"?><Error><Code>AuthenticationFailed</Code><Message>Server failed to authenticate the request.</Message><AuthenticationErrorDetail>Issuer validation failed.</AuthenticationErrorDetail></Error>`,
+			expectError: false,
+		},
+		{
+			name:        "authentication failed with a signature mismatch",
+			statusCode:  http.StatusForbidden,
+			errorCode:   "AuthenticationFailed",
+			body:        `<?xml version="1.0" encoding="utf-8"?><Error><Code>AuthenticationFailed</Code><Message>Server failed to authenticate the request. Make sure the value of Authorization header is formed correctly including the signature.</Message><AuthenticationErrorDetail>The MAC signature found in the HTTP request 'abc=' is not the same as any computed signature.</AuthenticationErrorDetail></Error>`,
+			expectError: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		resp := &http.Response{
+			StatusCode: v.statusCode,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader(v.body)),
+		}
+		if v.errorCode != "" {
+			resp.Header.Set("x-ms-error-code", v.errorCode)
+		}
+
+		sender := authenticationFailedSender{
+			sender: autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+				return resp, nil
+			}),
+		}
+		req, _ := http.NewRequest(http.MethodGet, "https://example.blob.core.windows.net/container", nil)
+		actual, err := sender.Do(req)
+		if v.expectError && err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		if !v.expectError && err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if err != nil && !strings.Contains(err.Error(), "clock") {
+			t.Fatalf("expected the error to mention clock skew but got: %+v", err)
+		}
+
+		// the response body should remain readable
+		body, err := io.ReadAll(actual.Body)
+		if err != nil {
+			t.Fatalf("reading the response body: %+v", err)
+		}
+		if !bytes.Equal(body, []byte(v.body)) {
+			t.Fatalf("expected the response body to be %q but got %q", v.body, string(body))
+		}
+	}
+}
+
+func TestWithColdAccessTierAPIVersion(t *testing.T) {
+	testData := []struct {
+		accessTier      string
+		version         string
+		expectedVersion string
+	}{
+		{
+			accessTier:      "",
+			version:         "2020-08-04",
+			expectedVersion: "2020-08-04",
+		},
+		{
+			accessTier:      "Hot",
+			version:         "2020-08-04",
+			expectedVersion: "2020-08-04",
+		},
+		{
+			accessTier:      "Cold",
+			version:         "2020-08-04",
+			expectedVersion: "2021-12-02",
+		},
+		{
+			accessTier:      "Cold",
+			version:         "2023-11-03",
+			expectedVersion: "2023-11-03",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q with version %q", v.accessTier, v.version)
+
+		req, _ := http.NewRequest(http.MethodPut, "https://example.blob.core.windows.net/container/blob?comp=tier", nil)
+		req.Header.Set("x-ms-version", v.version)
+		if v.accessTier != "" {
+			req.Header.Set("x-ms-access-tier", v.accessTier)
+		}
+		req, err := autorest.Prepare(req, withColdAccessTierAPIVersion())
+		if err != nil {
+			t.Fatalf("preparing request: %+v", err)
+		}
+
+		if actualVersion := req.Header.Get("x-ms-version"); actualVersion != v.expectedVersion {
+			t.Fatalf("expected the version to be %q but got %q", v.expectedVersion, actualVersion)
+		}
+	}
+}
+
+func TestWithClientRequestID(t *testing.T) {
+	testData := []struct {
+		id string
+	}{
+		{
+			id: "",
+		},
+		{
+			id: "my-correlation-id",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.id)
+
+		ids := make([]string, 0)
+		for i := 0; i < 2; i++ {
+			req, _ := http.NewRequest(http.MethodGet, "https://example.blob.core.windows.net/container?restype=container", nil)
+			req, err := autorest.Prepare(req, withClientRequestID(v.id))
+			if err != nil {
+				t.Fatalf("preparing request: %+v", err)
+			}
+			ids = append(ids, req.Header.Get(HeaderClientRequestID))
+		}
+
+		if v.id != "" {
+			if ids[0] != v.id || ids[1] != v.id {
+				t.Fatalf("expected the Client Request ID to be %q but got %q and %q", v.id, ids[0], ids[1])
+			}
+			continue
+		}
+
+		if ids[0] == "" || ids[1] == "" {
+			t.Fatalf("expected a Client Request ID to be generated but got %q and %q", ids[0], ids[1])
+		}
+		if ids[0] == ids[1] {
+			t.Fatalf("expected a unique Client Request ID to be generated for each request but got %q twice", ids[0])
+		}
+	}
+}
+
+func TestWithUserAgentSuffix(t *testing.T) {
+	testData := []struct {
+		userAgent string
+		suffix    string
+		expected  string
+	}{
+		{
+			userAgent: "Go/go1.21 (amd64-linux) go-autorest/v14.2.1 tombuildsstuff/giovanni/v0.20.0 storage/2020-08-04",
+			suffix:    "",
+			expected:  "Go/go1.21 (amd64-linux) go-autorest/v14.2.1 tombuildsstuff/giovanni/v0.20.0 storage/2020-08-04",
+		},
+		{
+			userAgent: "Go/go1.21 (amd64-linux) go-autorest/v14.2.1 tombuildsstuff/giovanni/v0.20.0 storage/2020-08-04",
+			suffix:    "  ",
+			expected:  "Go/go1.21 (amd64-linux) go-autorest/v14.2.1 tombuildsstuff/giovanni/v0.20.0 storage/2020-08-04",
+		},
+		{
+			userAgent: "Go/go1.21 (amd64-linux) go-autorest/v14.2.1 tombuildsstuff/giovanni/v0.20.0 storage/2020-08-04",
+			suffix:    "contoso-platform/1.0",
+			expected:  "Go/go1.21 (amd64-linux) go-autorest/v14.2.1 tombuildsstuff/giovanni/v0.20.0 storage/2020-08-04 contoso-platform/1.0",
+		},
+		{
+			userAgent: "",
+			suffix:    "contoso-platform/1.0",
+			expected:  "contoso-platform/1.0",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q with the suffix %q", v.userAgent, v.suffix)
+
+		if actual := withUserAgentSuffix(v.userAgent, v.suffix); actual != v.expected {
+			t.Fatalf("expected %q but got %q", v.expected, actual)
+		}
+	}
+}
+
+// a transport-level error (where no response is received) must be distinguishable from a genuine 404/409,
+// such that the error is surfaced rather than the Entity being assumed not to exist (or to already exist)
+func TestDataPlaneTransportErrorIsNotAStatusCode(t *testing.T) {
+	testData := []struct {
+		name       string
+		statusCode int
+		sendError  error
+		notFound   bool
+		conflict   bool
+	}{
+		{
+			name:      "transport error",
+			sendError: fmt.Errorf("dial tcp: lookup example.table.core.windows.net: no such host"),
+		},
+		{
+			name:       "not found",
+			statusCode: http.StatusNotFound,
+			notFound:   true,
+		},
+		{
+			name:       "conflict",
+			statusCode: http.StatusConflict,
+			conflict:   true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		entitiesClient := entities.NewWithEnvironment(azure.PublicCloud)
+		entitiesClient.RetryAttempts = 1
+		entitiesClient.RetryDuration = 0
+		entitiesClient.Sender = autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			if v.sendError != nil {
+				return nil, v.sendError
+			}
+			return &http.Response{
+				Request:    r,
+				StatusCode: v.statusCode,
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader("")),
+			}, nil
+		})
+		Client{}.configureDataPlaneClient(accountDetails{name: "example"}, &entitiesClient.Client)
+
+		getInput := entities.GetEntityInput{
+			PartitionKey:  "partition",
+			RowKey:        "row",
+			MetaDataLevel: entities.NoMetaData,
+		}
+		existing, err := entitiesClient.Get(context.Background(), "example", "table", getInput)
+		if err == nil {
+			t.Fatalf("expected an error from Get but didn't get one")
+		}
+		if actual := utils.ResponseWasNotFound(existing.Response); actual != v.notFound {
+			t.Fatalf("expected Get to be Not Found %t but got %t", v.notFound, actual)
+		}
+
+		insertInput := entities.InsertEntityInput{
+			PartitionKey:  "partition",
+			RowKey:        "row",
+			Entity:        map[string]interface{}{},
+			MetaDataLevel: entities.NoMetaData,
+		}
+		resp, err := entitiesClient.Insert(context.Background(), "example", "table", insertInput)
+		if err == nil {
+			t.Fatalf("expected an error from Insert but didn't get one")
+		}
+		if actual := utils.ResponseWasConflict(resp); actual != v.conflict {
+			t.Fatalf("expected Insert to be a Conflict %t but got %t", v.conflict, actual)
+		}
+	}
+}
+
+func TestDataPlaneOperationNotSupported(t *testing.T) {
+	testData := []struct {
+		name         string
+		statusCode   int
+		errorCode    string
+		sendError    error
+		notSupported bool
+	}{
+		{
+			name:         "not implemented",
+			statusCode:   http.StatusNotImplemented,
+			errorCode:    "NotImplemented",
+			notSupported: true,
+		},
+		{
+			name:         "feature not supported",
+			statusCode:   http.StatusBadRequest,
+			errorCode:    "FeatureNotSupportedForAccount",
+			notSupported: true,
+		},
+		{
+			name:       "other error",
+			statusCode: http.StatusBadRequest,
+			errorCode:  "InvalidXmlDocument",
+		},
+		{
+			name:       "not found",
+			statusCode: http.StatusNotFound,
+			errorCode:  "TableNotFound",
+		},
+		{
+			name:      "transport error",
+			sendError: fmt.Errorf("dial tcp: lookup example.table.core.windows.net: no such host"),
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		tablesClient := tables.NewWithEnvironment(azure.PublicCloud)
+		tablesClient.RetryAttempts = 1
+		tablesClient.RetryDuration = 0
+		tablesClient.Sender = autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			if v.sendError != nil {
+				return nil, v.sendError
+			}
+			return &http.Response{
+				Request:    r,
+				StatusCode: v.statusCode,
+				Header: http.Header{
+					"X-Ms-Error-Code": []string{v.errorCode},
+				},
+				Body: io.NopCloser(strings.NewReader("")),
+			}, nil
+		})
+
+		if _, err := tablesClient.GetACL(context.Background(), "example", "table"); err == nil {
+			t.Fatalf("expected an error from GetACL but didn't get one")
+		} else if actual := DataPlaneOperationNotSupported(err); actual != v.notSupported {
+			t.Fatalf("expected GetACL to be Not Supported %t but got %t", v.notSupported, actual)
+		}
+
+		if _, err := tablesClient.SetACL(context.Background(), "example", "table", []tables.SignedIdentifier{}); err == nil {
+			t.Fatalf("expected an error from SetACL but didn't get one")
+		} else if actual := DataPlaneOperationNotSupported(err); actual != v.notSupported {
+			t.Fatalf("expected SetACL to be Not Supported %t but got %t", v.notSupported, actual)
+		}
+	}
+}
+
+func TestValidateMinimumTLSVersion(t *testing.T) {
+	senderWithMaxVersion := func(maxVersion uint16) autorest.Sender {
+		return &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{
+					MinVersion: tls.VersionTLS10,
+					MaxVersion: maxVersion,
+				},
+			},
+		}
+	}
+	accountWithMinimumTLSVersion := func(input storage.MinimumTLSVersion) accountDetails {
+		return accountDetails{
+			name: "example",
+			Properties: &storage.AccountProperties{
+				MinimumTLSVersion: input,
+			},
+		}
+	}
+
+	testData := []struct {
+		name        string
+		account     accountDetails
+		sender      autorest.Sender
+		expectError bool
+	}{
+		{
+			name:    "no properties",
+			account: accountDetails{name: "example"},
+			sender:  senderWithMaxVersion(tls.VersionTLS11),
+		},
+		{
+			name:    "default transport",
+			account: accountWithMinimumTLSVersion(storage.MinimumTLSVersionTLS12),
+			sender:  &http.Client{Transport: &http.Transport{}},
+		},
+		{
+			name:    "unknown sender",
+			account: accountWithMinimumTLSVersion(storage.MinimumTLSVersionTLS12),
+			sender: autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+				return nil, nil
+			}),
+		},
+		{
+			name:    "maximum version meets the minimum",
+			account: accountWithMinimumTLSVersion(storage.MinimumTLSVersionTLS12),
+			sender:  senderWithMaxVersion(tls.VersionTLS12),
+		},
+		{
+			name:    "maximum version exceeds the minimum",
+			account: accountWithMinimumTLSVersion(storage.MinimumTLSVersionTLS11),
+			sender:  senderWithMaxVersion(tls.VersionTLS13),
+		},
+		{
+			name:        "maximum version below the minimum",
+			account:     accountWithMinimumTLSVersion(storage.MinimumTLSVersionTLS12),
+			sender:      senderWithMaxVersion(tls.VersionTLS11),
+			expectError: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		err := validateMinimumTLSVersion(v.account, v.sender)
+		if v.expectError && err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		if !v.expectError && err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+	}
+}
+
+func TestWithDataPlaneHosts(t *testing.T) {
+	account := accountDetails{
+		name: "example",
+		Properties: &storage.AccountProperties{
+			PrimaryEndpoints: &storage.Endpoints{
+				Blob:  utils.String("https://example.z12.blob.storage.azure.net/"),
+				Table: utils.String("https://example.table.core.windows.net/"),
+			},
+			SecondaryEndpoints: &storage.Endpoints{
+				Blob: utils.String("https://example-secondary.z12.blob.storage.azure.net/"),
+			},
+		},
+	}
+	hosts := account.dataPlaneHosts("core.windows.net")
+
+	testData := []struct {
+		uri          string
+		expectedHost string
+	}{
+		{
+			uri:          "https://example.blob.core.windows.net/container?restype=container",
+			expectedHost: "example.z12.blob.storage.azure.net",
+		},
+		{
+			uri:          "https://example-secondary.blob.core.windows.net/?restype=service&comp=stats",
+			expectedHost: "example-secondary.z12.blob.storage.azure.net",
+		},
+		{
+			// the Table endpoint matches the host which is built, so this is unchanged
+			uri:          "https://example.table.core.windows.net/table1",
+			expectedHost: "example.table.core.windows.net",
+		},
+		{
+			// there's no Queue endpoint for this account, so this is sent as-is
+			uri:          "https://example.queue.core.windows.net/queue1",
+			expectedHost: "example.queue.core.windows.net",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.uri)
+
+		req, _ := http.NewRequest(http.MethodGet, v.uri, nil)
+		req, err := autorest.Prepare(req, withDataPlaneHosts(hosts))
+		if err != nil {
+			t.Fatalf("preparing request: %+v", err)
+		}
+
+		if req.URL.Host != v.expectedHost {
+			t.Fatalf("expected the host to be %q but got %q", v.expectedHost, req.URL.Host)
+		}
+	}
+}
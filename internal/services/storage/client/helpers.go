@@ -7,10 +7,17 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
+	"net/url"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-09-01/storage" // nolint: staticcheck
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/shim"
 )
 
 var (
@@ -20,6 +27,51 @@ var (
 	credentialsLock = sync.RWMutex{}
 )
 
+// containersClientCache caches the Containers Client for each Storage Account. This is held by each Client rather
+// than being global, since each instance of the Provider (e.g. an aliased Provider) can use different credentials
+// and configure the Data Plane clients differently.
+type containersClientCache struct {
+	lock    sync.RWMutex
+	clients map[string]shim.StorageContainerWrapper
+}
+
+func newContainersClientCache() *containersClientCache {
+	return &containersClientCache{
+		clients: map[string]shim.StorageContainerWrapper{},
+	}
+}
+
+func (c *containersClientCache) get(accountName string) (shim.StorageContainerWrapper, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	existing, ok := c.clients[accountName]
+	return existing, ok
+}
+
+func (c *containersClientCache) set(accountName string, client shim.StorageContainerWrapper) {
+	if c == nil {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.clients[accountName] = client
+}
+
+func (c *containersClientCache) remove(accountName string) {
+	if c == nil {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.clients, accountName)
+}
+
 type accountDetails struct {
 	ID            string
 	Kind          storage.Kind
@@ -58,6 +110,131 @@ func (ad *accountDetails) AccountKey(ctx context.Context, client Client) (*strin
 	return ad.accountKey, nil
 }
 
+type EndpointType string
+
+const (
+	EndpointTypeBlob  EndpointType = "blob"
+	EndpointTypeDfs   EndpointType = "dfs"
+	EndpointTypeFile  EndpointType = "file"
+	EndpointTypeQueue EndpointType = "queue"
+	EndpointTypeTable EndpointType = "table"
+	EndpointTypeWeb   EndpointType = "web"
+)
+
+// DataPlaneEndpoint returns the Primary Endpoint of the specified type for this Storage Account, as resolved
+// by Azure - which (unlike building this from the account name) accounts for the current cloud and DNS zone
+func (ad accountDetails) DataPlaneEndpoint(endpointType EndpointType) (*string, error) {
+	if ad.Properties == nil || ad.Properties.PrimaryEndpoints == nil {
+		return nil, fmt.Errorf("storage account %q has no properties", ad.name)
+	}
+
+	var endpoint *string
+	switch endpointType {
+	case EndpointTypeBlob:
+		endpoint = ad.Properties.PrimaryEndpoints.Blob
+	case EndpointTypeDfs:
+		endpoint = ad.Properties.PrimaryEndpoints.Dfs
+	case EndpointTypeFile:
+		endpoint = ad.Properties.PrimaryEndpoints.File
+	case EndpointTypeQueue:
+		endpoint = ad.Properties.PrimaryEndpoints.Queue
+	case EndpointTypeTable:
+		endpoint = ad.Properties.PrimaryEndpoints.Table
+	case EndpointTypeWeb:
+		endpoint = ad.Properties.PrimaryEndpoints.Web
+	default:
+		return nil, fmt.Errorf("internal-error: unrecognised endpoint type %q when building storage endpoint", endpointType)
+	}
+
+	if endpoint == nil || *endpoint == "" {
+		// Storage Accounts only expose the endpoints for the services they support (for example a BlockBlobStorage
+		// account has no Table endpoint), so the endpoints which are available are listed to make this obvious
+		available := ad.availableEndpointTypes()
+		if len(available) == 0 {
+			return nil, fmt.Errorf("the %s endpoint was not found for storage account %q (Kind %q), which doesn't expose any data plane endpoints", endpointType, ad.name, ad.Kind)
+		}
+		return nil, fmt.Errorf("the %s endpoint was not found for storage account %q (Kind %q), which only exposes the %s endpoints - check that the storage account supports the service being managed", endpointType, ad.name, ad.Kind, strings.Join(available, ", "))
+	}
+
+	uri, err := normalizeDataPlaneEndpoint(*endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("parsing the %s endpoint %q for storage account %q: %+v", endpointType, *endpoint, ad.name, err)
+	}
+
+	// requests made over http are rejected when secure transfer is required, which otherwise surfaces as a rather
+	// opaque error from the Data Plane API - so this is caught up-front
+	if ad.SecureTransferRequired() && !strings.EqualFold(uri.Scheme, "https") {
+		return nil, fmt.Errorf("the %s endpoint %q for storage account %q doesn't use https, however the storage account requires secure transfer (`enable_https_traffic_only`) - as such requests made to this endpoint would be rejected", endpointType, *endpoint, ad.name)
+	}
+
+	normalized := uri.String()
+	return &normalized, nil
+}
+
+// DataPlaneHost returns the segments of the host for the specified Data Plane endpoint, which are used to build the
+// ID of a resource within this Storage Account - since for a Storage Account using an Azure DNS Zone endpoint the
+// host (`{accountName}.{dnsZone}.{endpointType}.{domainSuffix}`) can't be built from the current Environment alone
+func (ad accountDetails) DataPlaneHost(endpointType EndpointType) (*parse.DataPlaneHostDetails, error) {
+	endpoint, err := ad.DataPlaneEndpoint(endpointType)
+	if err != nil {
+		return nil, err
+	}
+
+	host, err := parse.DataPlaneHost(*endpoint, string(endpointType))
+	if err != nil {
+		return nil, fmt.Errorf("parsing the %s endpoint %q for storage account %q: %+v", endpointType, *endpoint, ad.name, err)
+	}
+	return host, nil
+}
+
+// availableEndpointTypes returns the types of the Primary Endpoints which are exposed by this Storage Account
+func (ad accountDetails) availableEndpointTypes() []string {
+	output := make([]string, 0)
+	if ad.Properties == nil || ad.Properties.PrimaryEndpoints == nil {
+		return output
+	}
+
+	endpoints := ad.Properties.PrimaryEndpoints
+	for _, v := range []struct {
+		endpointType EndpointType
+		endpoint     *string
+	}{
+		{EndpointTypeBlob, endpoints.Blob},
+		{EndpointTypeDfs, endpoints.Dfs},
+		{EndpointTypeFile, endpoints.File},
+		{EndpointTypeQueue, endpoints.Queue},
+		{EndpointTypeTable, endpoints.Table},
+		{EndpointTypeWeb, endpoints.Web},
+	} {
+		if v.endpoint != nil && *v.endpoint != "" {
+			output = append(output, string(v.endpointType))
+		}
+	}
+	return output
+}
+
+// normalizeDataPlaneEndpoint parses the endpoint returned from Azure, removing any trailing slash from the path - the
+// port, query string and any other components are retained, since Storage Accounts using a custom domain or private
+// endpoint can return an endpoint containing these (where naively trimming the string would break the endpoint)
+func normalizeDataPlaneEndpoint(input string) (*url.URL, error) {
+	uri, err := url.Parse(input)
+	if err != nil {
+		return nil, err
+	}
+	if uri.Scheme == "" || uri.Host == "" {
+		return nil, fmt.Errorf("expected an absolute URI containing a scheme and host")
+	}
+
+	uri.Path = strings.TrimSuffix(uri.Path, "/")
+	uri.RawPath = strings.TrimSuffix(uri.RawPath, "/")
+	return uri, nil
+}
+
+// SecureTransferRequired returns whether the Storage Account only accepts requests made over https
+func (ad accountDetails) SecureTransferRequired() bool {
+	return ad.Properties != nil && ad.Properties.EnableHTTPSTrafficOnly != nil && *ad.Properties.EnableHTTPSTrafficOnly
+}
+
 func (client Client) AddToCache(accountName string, props storage.Account) error {
 	accountsLock.Lock()
 	defer accountsLock.Unlock()
@@ -69,6 +246,9 @@ func (client Client) AddToCache(accountName string, props storage.Account) error
 
 	storageAccountsCache[accountName] = *account
 
+	// the Storage Account may have been recreated, so any cached Containers Client may be using a stale Account Key
+	client.containersClients.remove(accountName)
+
 	return nil
 }
 
@@ -76,6 +256,8 @@ func (client Client) RemoveAccountFromCache(accountName string) {
 	accountsLock.Lock()
 	delete(storageAccountsCache, accountName)
 	accountsLock.Unlock()
+
+	client.containersClients.remove(accountName)
 }
 
 func (client Client) FindAccount(ctx context.Context, accountName string) (*accountDetails, error) {
@@ -86,7 +268,11 @@ func (client Client) FindAccount(ctx context.Context, accountName string) (*acco
 		return &existing, nil
 	}
 
-	accountsPage, err := client.AccountsClient.List(ctx)
+	var accountsPage storage.AccountListResultPage
+	err := retryWhenThrottled(ctx, "listing storage accounts", func() (err error) {
+		accountsPage, err = client.AccountsClient.List(ctx)
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("retrieving storage accounts: %+v", err)
 	}
@@ -94,7 +280,9 @@ func (client Client) FindAccount(ctx context.Context, accountName string) (*acco
 	var accounts []storage.Account
 	for accountsPage.NotDone() {
 		accounts = append(accounts, accountsPage.Values()...)
-		err = accountsPage.NextWithContext(ctx)
+		err = retryWhenThrottled(ctx, "listing the next page of storage accounts", func() error {
+			return accountsPage.NextWithContext(ctx)
+		})
 		if err != nil {
 			return nil, fmt.Errorf("retrieving next page of storage accounts: %+v", err)
 		}
@@ -140,3 +328,116 @@ func populateAccountDetails(accountName string, props storage.Account) (*account
 		Properties:    props.AccountProperties,
 	}, nil
 }
+
+// SharedKeyAccessDisabled returns whether this Storage Account only permits requests authorized using Azure AD
+func (ad accountDetails) SharedKeyAccessDisabled() bool {
+	return ad.Properties != nil && ad.Properties.AllowSharedKeyAccess != nil && !*ad.Properties.AllowSharedKeyAccess
+}
+
+// AccountCapabilities describes the properties of a Storage Account which determine which features are available
+// for the resources within it - allowing these to be validated at plan time
+type AccountCapabilities struct {
+	Kind                  storage.Kind
+	SkuName               storage.SkuName
+	IsHnsEnabled          bool
+	AllowBlobPublicAccess bool
+	SharedKeyEnabled      bool
+}
+
+// Capabilities returns the AccountCapabilities for this Storage Account
+func (ad accountDetails) Capabilities() AccountCapabilities {
+	capabilities := AccountCapabilities{
+		Kind:         ad.Kind,
+		IsHnsEnabled: ad.IsHnsEnabled(),
+		// both of these default to being enabled when they're not returned from the API
+		AllowBlobPublicAccess: true,
+		SharedKeyEnabled:      !ad.SharedKeyAccessDisabled(),
+	}
+	if ad.Sku != nil {
+		capabilities.SkuName = ad.Sku.Name
+	}
+	if ad.Properties != nil && ad.Properties.AllowBlobPublicAccess != nil {
+		capabilities.AllowBlobPublicAccess = *ad.Properties.AllowBlobPublicAccess
+	}
+	return capabilities
+}
+
+// FindAccountCapabilities returns the AccountCapabilities for the Storage Account with the specified name (or
+// Resource ID). This uses the same cache as FindAccount, meaning the Storage Accounts are only listed once, rather
+// than being retrieved by each resource. nil is returned when the Storage Account doesn't exist (yet).
+func (client Client) FindAccountCapabilities(ctx context.Context, accountNameOrId string) (*AccountCapabilities, error) {
+	accountName := accountNameOrId
+	if id, err := commonids.ParseStorageAccountIDInsensitively(accountNameOrId); err == nil {
+		accountName = id.StorageAccountName
+	}
+
+	account, err := client.FindAccount(ctx, accountName)
+	if err != nil {
+		return nil, err
+	}
+	if account == nil {
+		return nil, nil
+	}
+
+	capabilities := account.Capabilities()
+	return &capabilities, nil
+}
+
+// IsHnsEnabled returns whether the Hierarchical Namespace (Data Lake Storage Gen2) is enabled for this Storage
+// Account, in which case each Container is also a Data Lake Gen2 File System
+func (ad accountDetails) IsHnsEnabled() bool {
+	return ad.Properties != nil && ad.Properties.IsHnsEnabled != nil && *ad.Properties.IsHnsEnabled
+}
+
+// PrimaryLocation returns the location of the primary for this Storage Account, which is empty when unknown
+func (ad accountDetails) PrimaryLocation() string {
+	if ad.Properties != nil && ad.Properties.PrimaryLocation != nil {
+		return *ad.Properties.PrimaryLocation
+	}
+	return ""
+}
+
+// SecondaryLocation returns the location of the geo-replicated secondary for this Storage Account, which is empty
+// when the Storage Account isn't geo-redundant (or the secondary is unavailable)
+func (ad accountDetails) SecondaryLocation() string {
+	if ad.Properties != nil && ad.Properties.SecondaryLocation != nil {
+		return *ad.Properties.SecondaryLocation
+	}
+	return ""
+}
+
+const (
+	throttledRetryAttempts     = 8
+	throttledRetryInitialDelay = 5 * time.Second
+	throttledRetryMaximumDelay = 2 * time.Minute
+)
+
+// retryWhenThrottled retries the specified operation when it's throttled by Resource Manager (returning a 429),
+// waiting for the duration specified in the `Retry-After` header if present - else backing off exponentially
+func retryWhenThrottled(ctx context.Context, operation string, f func() error) error {
+	delay := throttledRetryInitialDelay
+	for attempt := 1; ; attempt++ {
+		err := f()
+		if err == nil || attempt == throttledRetryAttempts {
+			return err
+		}
+
+		detailed, ok := err.(autorest.DetailedError)
+		if !ok || detailed.Response == nil || detailed.Response.StatusCode != http.StatusTooManyRequests {
+			return err
+		}
+
+		wait := autorest.GetRetryAfter(detailed.Response, delay)
+		log.Printf("[DEBUG] %s was throttled (attempt %d of %d) - retrying in %s..", operation, attempt, throttledRetryAttempts, wait)
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("waiting to retry %s: %+v", operation, ctx.Err())
+		case <-time.After(wait):
+		}
+
+		delay *= 2
+		if delay > throttledRetryMaximumDelay {
+			delay = throttledRetryMaximumDelay
+		}
+	}
+}
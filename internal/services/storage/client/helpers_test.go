@@ -0,0 +1,517 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-09-01/storage" // nolint: staticcheck
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/shim"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/blob/containers"
+)
+
+func TestRetryWhenThrottled(t *testing.T) {
+	throttled := func() error {
+		resp := &http.Response{
+			StatusCode: http.StatusTooManyRequests,
+			Header: http.Header{
+				"Retry-After": []string{"0"},
+			},
+		}
+		return autorest.NewErrorWithResponse("storage.AccountsClient", "List", resp, "Failure responding to request")
+	}
+
+	testData := []struct {
+		name             string
+		failures         int
+		failure          func() error
+		expectError      bool
+		expectedAttempts int
+	}{
+		{
+			name:             "success",
+			failures:         0,
+			expectError:      false,
+			expectedAttempts: 1,
+		},
+		{
+			name:             "throttled then success",
+			failures:         3,
+			failure:          throttled,
+			expectError:      false,
+			expectedAttempts: 4,
+		},
+		{
+			name:             "always throttled",
+			failures:         100,
+			failure:          throttled,
+			expectError:      true,
+			expectedAttempts: throttledRetryAttempts,
+		},
+		{
+			name:     "other error",
+			failures: 100,
+			failure: func() error {
+				return fmt.Errorf("boom")
+			},
+			expectError:      true,
+			expectedAttempts: 1,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		attempts := 0
+		err := retryWhenThrottled(context.Background(), "testing", func() error {
+			attempts++
+			if attempts <= v.failures {
+				return v.failure()
+			}
+			return nil
+		})
+
+		if v.expectError && err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		if !v.expectError && err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if attempts != v.expectedAttempts {
+			t.Fatalf("expected %d attempts but got %d", v.expectedAttempts, attempts)
+		}
+	}
+}
+
+func TestDataPlaneEndpoint(t *testing.T) {
+	account := accountDetails{
+		name: "example",
+		Properties: &storage.AccountProperties{
+			PrimaryEndpoints: &storage.Endpoints{
+				Blob:  pointer.To("https://example.blob.core.usgovcloudapi.net/"),
+				Table: pointer.To("https://example.table.core.usgovcloudapi.net/"),
+			},
+		},
+	}
+
+	testData := []struct {
+		name         string
+		account      accountDetails
+		endpointType EndpointType
+		expected     string
+		expectError  bool
+	}{
+		{
+			name:         "blob",
+			account:      account,
+			endpointType: EndpointTypeBlob,
+			expected:     "https://example.blob.core.usgovcloudapi.net",
+		},
+		{
+			name:         "table",
+			account:      account,
+			endpointType: EndpointTypeTable,
+			expected:     "https://example.table.core.usgovcloudapi.net",
+		},
+		{
+			name:         "endpoint not returned",
+			account:      account,
+			endpointType: EndpointTypeQueue,
+			expectError:  true,
+		},
+		{
+			name:         "unknown endpoint type",
+			account:      account,
+			endpointType: EndpointType("other"),
+			expectError:  true,
+		},
+		{
+			name:         "no properties",
+			account:      accountDetails{name: "example"},
+			endpointType: EndpointTypeTable,
+			expectError:  true,
+		},
+		{
+			name: "http endpoint without secure transfer",
+			account: accountDetails{
+				name: "example",
+				Properties: &storage.AccountProperties{
+					EnableHTTPSTrafficOnly: pointer.To(false),
+					PrimaryEndpoints: &storage.Endpoints{
+						Table: pointer.To("http://example.table.core.windows.net/"),
+					},
+				},
+			},
+			endpointType: EndpointTypeTable,
+			expected:     "http://example.table.core.windows.net",
+		},
+		{
+			name: "http endpoint with secure transfer",
+			account: accountDetails{
+				name: "example",
+				Properties: &storage.AccountProperties{
+					EnableHTTPSTrafficOnly: pointer.To(true),
+					PrimaryEndpoints: &storage.Endpoints{
+						Table: pointer.To("http://example.table.core.windows.net/"),
+					},
+				},
+			},
+			endpointType: EndpointTypeTable,
+			expectError:  true,
+		},
+		{
+			name: "https endpoint with secure transfer",
+			account: accountDetails{
+				name: "example",
+				Properties: &storage.AccountProperties{
+					EnableHTTPSTrafficOnly: pointer.To(true),
+					PrimaryEndpoints: &storage.Endpoints{
+						Table: pointer.To("https://example.table.core.windows.net/"),
+					},
+				},
+			},
+			endpointType: EndpointTypeTable,
+			expected:     "https://example.table.core.windows.net",
+		},
+		{
+			name:         "endpoint with a port",
+			account:      accountWithTableEndpoint("https://example.table.core.windows.net:8443/"),
+			endpointType: EndpointTypeTable,
+			expected:     "https://example.table.core.windows.net:8443",
+		},
+		{
+			name:         "endpoint with a path",
+			account:      accountWithTableEndpoint("https://storage.contoso.com:8443/example/table/"),
+			endpointType: EndpointTypeTable,
+			expected:     "https://storage.contoso.com:8443/example/table",
+		},
+		{
+			name:         "endpoint with a query string",
+			account:      accountWithTableEndpoint("https://example.table.core.windows.net/?region=west"),
+			endpointType: EndpointTypeTable,
+			expected:     "https://example.table.core.windows.net?region=west",
+		},
+		{
+			name:         "endpoint without a trailing slash",
+			account:      accountWithTableEndpoint("https://example.table.core.windows.net"),
+			endpointType: EndpointTypeTable,
+			expected:     "https://example.table.core.windows.net",
+		},
+		{
+			name:         "malformed endpoint",
+			account:      accountWithTableEndpoint("https://example.table.core.windows.net:port/"),
+			endpointType: EndpointTypeTable,
+			expectError:  true,
+		},
+		{
+			name:         "relative endpoint",
+			account:      accountWithTableEndpoint("example.table.core.windows.net/"),
+			endpointType: EndpointTypeTable,
+			expectError:  true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		actual, err := v.account.DataPlaneEndpoint(v.endpointType)
+		if err != nil {
+			if v.expectError {
+				continue
+			}
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if v.expectError {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		if *actual != v.expected {
+			t.Fatalf("expected %q but got %q", v.expected, *actual)
+		}
+	}
+}
+
+func TestDataPlaneHost(t *testing.T) {
+	testData := []struct {
+		name     string
+		endpoint string
+		expected parse.DataPlaneHostDetails
+	}{
+		{
+			name:     "classic",
+			endpoint: "https://example.blob.core.windows.net/",
+			expected: parse.DataPlaneHostDetails{
+				AccountName:  "example",
+				DomainSuffix: "core.windows.net",
+			},
+		},
+		{
+			name:     "dns zone",
+			endpoint: "https://example.z12.blob.storage.azure.net/",
+			expected: parse.DataPlaneHostDetails{
+				AccountName:  "example",
+				DnsZone:      "z12",
+				DomainSuffix: "storage.azure.net",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		account := accountDetails{
+			name: "example",
+			Properties: &storage.AccountProperties{
+				PrimaryEndpoints: &storage.Endpoints{
+					Blob: pointer.To(v.endpoint),
+				},
+			},
+		}
+		actual, err := account.DataPlaneHost(EndpointTypeBlob)
+		if err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if *actual != v.expected {
+			t.Fatalf("expected %+v but got %+v", v.expected, *actual)
+		}
+	}
+}
+
+func TestDataPlaneEndpointMissingListsAvailableEndpoints(t *testing.T) {
+	testData := []struct {
+		name     string
+		account  accountDetails
+		expected string
+	}{
+		{
+			name: "table only",
+			account: accountDetails{
+				name: "example",
+				Kind: storage.KindStorageV2,
+				Properties: &storage.AccountProperties{
+					PrimaryEndpoints: &storage.Endpoints{
+						Table: pointer.To("https://example.table.core.windows.net/"),
+					},
+				},
+			},
+			expected: "which only exposes the table endpoints",
+		},
+		{
+			name: "multiple endpoints",
+			account: accountDetails{
+				name: "example",
+				Kind: storage.KindStorageV2,
+				Properties: &storage.AccountProperties{
+					PrimaryEndpoints: &storage.Endpoints{
+						Dfs:   pointer.To("https://example.dfs.core.windows.net/"),
+						Queue: pointer.To("https://example.queue.core.windows.net/"),
+						Table: pointer.To("https://example.table.core.windows.net/"),
+					},
+				},
+			},
+			expected: "which only exposes the dfs, queue, table endpoints",
+		},
+		{
+			name: "no endpoints",
+			account: accountDetails{
+				name: "example",
+				Kind: storage.KindStorageV2,
+				Properties: &storage.AccountProperties{
+					PrimaryEndpoints: &storage.Endpoints{},
+				},
+			},
+			expected: "which doesn't expose any data plane endpoints",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		_, err := v.account.DataPlaneEndpoint(EndpointTypeBlob)
+		if err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		if !strings.Contains(err.Error(), v.expected) {
+			t.Fatalf("expected the error to contain %q but got %q", v.expected, err.Error())
+		}
+	}
+}
+
+func accountWithTableEndpoint(endpoint string) accountDetails {
+	return accountDetails{
+		name: "example",
+		Properties: &storage.AccountProperties{
+			PrimaryEndpoints: &storage.Endpoints{
+				Table: pointer.To(endpoint),
+			},
+		},
+	}
+}
+
+func TestAccountDetailsIsHnsEnabled(t *testing.T) {
+	testData := []struct {
+		name       string
+		properties *storage.AccountProperties
+		expected   bool
+	}{
+		{
+			name:       "no properties",
+			properties: nil,
+			expected:   false,
+		},
+		{
+			name:       "not set",
+			properties: &storage.AccountProperties{},
+			expected:   false,
+		},
+		{
+			name: "disabled",
+			properties: &storage.AccountProperties{
+				IsHnsEnabled: pointer.To(false),
+			},
+			expected: false,
+		},
+		{
+			name: "enabled",
+			properties: &storage.AccountProperties{
+				IsHnsEnabled: pointer.To(true),
+			},
+			expected: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		account := accountDetails{
+			Properties: v.properties,
+		}
+		if actual := account.IsHnsEnabled(); actual != v.expected {
+			t.Fatalf("expected %t but got %t", v.expected, actual)
+		}
+	}
+}
+
+func TestAccountDetailsLocations(t *testing.T) {
+	testData := []struct {
+		name              string
+		properties        *storage.AccountProperties
+		expectedPrimary   string
+		expectedSecondary string
+	}{
+		{
+			name:       "no properties",
+			properties: nil,
+		},
+		{
+			name: "locally redundant",
+			properties: &storage.AccountProperties{
+				PrimaryLocation: pointer.To("westeurope"),
+			},
+			expectedPrimary: "westeurope",
+		},
+		{
+			name: "geo redundant",
+			properties: &storage.AccountProperties{
+				PrimaryLocation:   pointer.To("westeurope"),
+				SecondaryLocation: pointer.To("northeurope"),
+			},
+			expectedPrimary:   "westeurope",
+			expectedSecondary: "northeurope",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		account := accountDetails{
+			Properties: v.properties,
+		}
+		if actual := account.PrimaryLocation(); actual != v.expectedPrimary {
+			t.Fatalf("expected the primary location to be %q but got %q", v.expectedPrimary, actual)
+		}
+		if actual := account.SecondaryLocation(); actual != v.expectedSecondary {
+			t.Fatalf("expected the secondary location to be %q but got %q", v.expectedSecondary, actual)
+		}
+	}
+}
+
+func TestAccountDetailsCapabilities(t *testing.T) {
+	testData := []struct {
+		name     string
+		account  accountDetails
+		expected AccountCapabilities
+	}{
+		{
+			name: "defaults",
+			account: accountDetails{
+				Kind: storage.KindStorageV2,
+			},
+			expected: AccountCapabilities{
+				Kind:                  storage.KindStorageV2,
+				AllowBlobPublicAccess: true,
+				SharedKeyEnabled:      true,
+			},
+		},
+		{
+			name: "restricted",
+			account: accountDetails{
+				Kind: storage.KindBlockBlobStorage,
+				Sku: &storage.Sku{
+					Name: storage.SkuNamePremiumLRS,
+				},
+				Properties: &storage.AccountProperties{
+					AllowBlobPublicAccess: pointer.To(false),
+					AllowSharedKeyAccess:  pointer.To(false),
+					IsHnsEnabled:          pointer.To(true),
+				},
+			},
+			expected: AccountCapabilities{
+				Kind:                  storage.KindBlockBlobStorage,
+				SkuName:               storage.SkuNamePremiumLRS,
+				IsHnsEnabled:          true,
+				AllowBlobPublicAccess: false,
+				SharedKeyEnabled:      false,
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		if actual := v.account.Capabilities(); actual != v.expected {
+			t.Fatalf("expected %+v but got %+v", v.expected, actual)
+		}
+	}
+}
+
+func TestContainersClientCacheIsPerClient(t *testing.T) {
+	first := Client{
+		containersClients: newContainersClientCache(),
+	}
+	second := Client{
+		containersClients: newContainersClientCache(),
+	}
+
+	containersClient := containers.New()
+	first.containersClients.set("example", shim.NewDataPlaneStorageContainerWrapper(&containersClient))
+
+	if _, ok := first.containersClients.get("example"); !ok {
+		t.Fatalf("expected the Containers Client to be cached for the first Client")
+	}
+	if _, ok := second.containersClients.get("example"); ok {
+		t.Fatalf("expected the Containers Client cached for the first Client not to be used by the second Client")
+	}
+
+	first.RemoveAccountFromCache("example")
+	if _, ok := first.containersClients.get("example"); ok {
+		t.Fatalf("expected the Containers Client to be removed from the cache alongside the Storage Account")
+	}
+}
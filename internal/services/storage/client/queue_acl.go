@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/autorest/validation"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/queue/queues"
+)
+
+// QueueACLClient manages the Stored Access Policies (ACL's) for a Storage Queue, since these operations aren't
+// available in the Queues Client.
+type QueueACLClient struct {
+	dataPlaneClient
+}
+
+type QueueSignedIdentifier struct {
+	Id           string            `xml:"Id"`
+	AccessPolicy QueueAccessPolicy `xml:"AccessPolicy"`
+}
+
+type QueueAccessPolicy struct {
+	Start      string `xml:"Start"`
+	Expiry     string `xml:"Expiry"`
+	Permission string `xml:"Permission"`
+}
+
+type GetQueueACLResult struct {
+	autorest.Response
+
+	SignedIdentifiers []QueueSignedIdentifier `xml:"SignedIdentifier"`
+}
+
+type setQueueACL struct {
+	SignedIdentifiers []QueueSignedIdentifier `xml:"SignedIdentifier"`
+
+	XMLName xml.Name `xml:"SignedIdentifiers"`
+}
+
+func (client Client) QueueACLClient(ctx context.Context, account accountDetails) (*QueueACLClient, error) {
+	// the Queues Client is built in the same manner so that this is authorized and configured in the same way
+	queuesClient, err := client.queuesDataPlaneClient(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	return &QueueACLClient{
+		dataPlaneClient: newDataPlaneClient(queuesClient.Client, queuesClient.BaseURI),
+	}, nil
+}
+
+// GetACL returns the Stored Access Policies for the specified Storage Queue
+func (client QueueACLClient) GetACL(ctx context.Context, accountName, queueName string) (result GetQueueACLResult, err error) {
+	if err := validateQueueACLInput("GetACL", accountName, queueName); err != nil {
+		return result, err
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(client.endpoint(accountName, "queue")),
+		autorest.WithPathParameters("/{queueName}", client.pathParameters(queueName)),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"comp": autorest.Encode("query", "acl"),
+		}),
+		autorest.WithHeaders(map[string]interface{}{
+			"x-ms-version": queues.APIVersion,
+		}))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "client.QueueACLClient", "GetACL", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := autorest.SendWithSender(client, req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "client.QueueACLClient", "GetACL", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingXML(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "client.QueueACLClient", "GetACL", resp, "Failure responding to request")
+	}
+	return
+}
+
+// SetACL replaces the Stored Access Policies for the specified Storage Queue
+func (client QueueACLClient) SetACL(ctx context.Context, accountName, queueName string, acls []QueueSignedIdentifier) (result autorest.Response, err error) {
+	if err := validateQueueACLInput("SetACL", accountName, queueName); err != nil {
+		return result, err
+	}
+	if len(acls) > 5 {
+		return result, validation.NewError("client.QueueACLClient", "SetACL", "a maximum of 5 Stored Access Policies can be set on a Queue.")
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/xml; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(client.endpoint(accountName, "queue")),
+		autorest.WithPathParameters("/{queueName}", client.pathParameters(queueName)),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"comp": autorest.Encode("query", "acl"),
+		}),
+		autorest.WithHeaders(map[string]interface{}{
+			"x-ms-version": queues.APIVersion,
+		}),
+		autorest.WithXML(setQueueACL{
+			SignedIdentifiers: acls,
+		}))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "client.QueueACLClient", "SetACL", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := autorest.SendWithSender(client, req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		result = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "client.QueueACLClient", "SetACL", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusNoContent),
+		autorest.ByClosing())
+	result = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "client.QueueACLClient", "SetACL", resp, "Failure responding to request")
+	}
+	return
+}
+
+func (client QueueACLClient) pathParameters(queueName string) map[string]interface{} {
+	return map[string]interface{}{
+		"queueName": autorest.Encode("path", queueName),
+	}
+}
+
+func validateQueueACLInput(operation, accountName, queueName string) error {
+	if accountName == "" {
+		return validation.NewError("client.QueueACLClient", operation, "`accountName` cannot be an empty string.")
+	}
+	if queueName == "" {
+		return validation.NewError("client.QueueACLClient", operation, "`queueName` cannot be an empty string.")
+	}
+	if strings.ToLower(queueName) != queueName {
+		return validation.NewError("client.QueueACLClient", operation, "`queueName` must be a lower-cased string.")
+	}
+	return nil
+}
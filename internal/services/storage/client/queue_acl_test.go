@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestQueueACLClientGetACL(t *testing.T) {
+	testData := []struct {
+		name     string
+		body     string
+		expected []QueueSignedIdentifier
+	}{
+		{
+			name:     "none",
+			body:     `<?xml version="1.0" encoding="utf-8"?><SignedIdentifiers />`,
+			expected: nil,
+		},
+		{
+			name: "multiple",
+			body: `<?xml version="1.0" encoding="utf-8"?><SignedIdentifiers><SignedIdentifier><Id>first</Id><AccessPolicy><Start>2023-01-01T00:00:00.0000000Z</Start><Expiry>2099-01-01T00:00:00.0000000Z</Expiry><Permission>raup</Permission></AccessPolicy></SignedIdentifier><SignedIdentifier><Id>second</Id><AccessPolicy><Permission>r</Permission></AccessPolicy></SignedIdentifier></SignedIdentifiers>`,
+			expected: []QueueSignedIdentifier{
+				{
+					Id: "first",
+					AccessPolicy: QueueAccessPolicy{
+						Start:      "2023-01-01T00:00:00.0000000Z",
+						Expiry:     "2099-01-01T00:00:00.0000000Z",
+						Permission: "raup",
+					},
+				},
+				{
+					Id: "second",
+					AccessPolicy: QueueAccessPolicy{
+						Permission: "r",
+					},
+				},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		var actual *http.Request
+		client := QueueACLClient{
+			dataPlaneClient: testDataPlaneClient(func(r *http.Request) *http.Response {
+				actual = r
+				return &http.Response{
+					Request:    r,
+					StatusCode: http.StatusOK,
+					Header:     http.Header{},
+					Body:       io.NopCloser(strings.NewReader(v.body)),
+				}
+			}),
+		}
+
+		result, err := client.GetACL(context.Background(), "example", "queue1")
+		if err != nil {
+			t.Fatalf("retrieving ACL: %+v", err)
+		}
+
+		if actual.Method != http.MethodGet {
+			t.Fatalf("expected the method to be %q but got %q", http.MethodGet, actual.Method)
+		}
+		if actual.URL.Host != "example.queue.core.windows.net" || actual.URL.Path != "/queue1" {
+			t.Fatalf("expected the URL to be %q but got %q", "example.queue.core.windows.net/queue1", actual.URL.Host+actual.URL.Path)
+		}
+		if actual.URL.RawQuery != "comp=acl" {
+			t.Fatalf("expected the query to be %q but got %q", "comp=acl", actual.URL.RawQuery)
+		}
+		if !reflect.DeepEqual(result.SignedIdentifiers, v.expected) {
+			t.Fatalf("expected %+v but got %+v", v.expected, result.SignedIdentifiers)
+		}
+	}
+}
+
+func TestQueueACLClientSetACL(t *testing.T) {
+	testData := []struct {
+		name         string
+		acls         []QueueSignedIdentifier
+		expectedBody string
+	}{
+		{
+			name:         "none",
+			acls:         []QueueSignedIdentifier{},
+			expectedBody: "<SignedIdentifiers></SignedIdentifiers>",
+		},
+		{
+			name: "single",
+			acls: []QueueSignedIdentifier{
+				{
+					Id: "first",
+					AccessPolicy: QueueAccessPolicy{
+						Start:      "2023-01-01T00:00:00.0000000Z",
+						Expiry:     "2099-01-01T00:00:00.0000000Z",
+						Permission: "raup",
+					},
+				},
+			},
+			expectedBody: "<SignedIdentifiers><SignedIdentifier><Id>first</Id><AccessPolicy><Start>2023-01-01T00:00:00.0000000Z</Start><Expiry>2099-01-01T00:00:00.0000000Z</Expiry><Permission>raup</Permission></AccessPolicy></SignedIdentifier></SignedIdentifiers>",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		var actual *http.Request
+		var actualBody string
+		client := QueueACLClient{
+			dataPlaneClient: testDataPlaneClient(func(r *http.Request) *http.Response {
+				actual = r
+				body, _ := io.ReadAll(r.Body)
+				actualBody = string(body)
+				return &http.Response{
+					Request:    r,
+					StatusCode: http.StatusNoContent,
+					Header:     http.Header{},
+					Body:       io.NopCloser(strings.NewReader("")),
+				}
+			}),
+		}
+
+		if _, err := client.SetACL(context.Background(), "example", "queue1", v.acls); err != nil {
+			t.Fatalf("setting ACL: %+v", err)
+		}
+
+		if actual.Method != http.MethodPut {
+			t.Fatalf("expected the method to be %q but got %q", http.MethodPut, actual.Method)
+		}
+		if actual.URL.RawQuery != "comp=acl" {
+			t.Fatalf("expected the query to be %q but got %q", "comp=acl", actual.URL.RawQuery)
+		}
+		if actualBody = strings.TrimPrefix(actualBody, xml.Header); actualBody != v.expectedBody {
+			t.Fatalf("expected the body to be %q but got %q", v.expectedBody, actualBody)
+		}
+	}
+}
+
+func TestQueueACLClientSetACLTooMany(t *testing.T) {
+	client := QueueACLClient{
+		dataPlaneClient: testDataPlaneClient(func(r *http.Request) *http.Response {
+			t.Fatalf("expected no request to be sent")
+			return nil
+		}),
+	}
+
+	acls := make([]QueueSignedIdentifier, 0)
+	for _, id := range []string{"a", "b", "c", "d", "e", "f"} {
+		acls = append(acls, QueueSignedIdentifier{Id: id})
+	}
+
+	if _, err := client.SetACL(context.Background(), "example", "queue1", acls); err == nil {
+		t.Fatalf("expected an error for 6 ACL's but didn't get one")
+	}
+}
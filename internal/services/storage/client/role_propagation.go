@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"bytes"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+const (
+	rolePropagationRetryInitialDelay = 5 * time.Second
+	rolePropagationRetryMaximumDelay = 30 * time.Second
+)
+
+// retryDuringRolePropagation retries Data Plane requests which are authorized using Azure AD and fail with a 403
+// AuthorizationPermissionMismatch, for up to the window configured using `storage_azuread_role_propagation_retry_seconds`.
+// Role Assignments granting access to the Data Plane can take several minutes to propagate, meaning requests made
+// immediately after these are created (e.g. in the same apply) otherwise fail.
+func (client Client) retryDuringRolePropagation(c *autorest.Client) {
+	if client.storageAdAuth == nil || client.rolePropagationRetryWindow <= 0 {
+		return
+	}
+
+	c.Sender = rolePropagationRetrySender{
+		sender:       c.Sender,
+		window:       client.rolePropagationRetryWindow,
+		initialDelay: rolePropagationRetryInitialDelay,
+	}
+}
+
+type rolePropagationRetrySender struct {
+	sender       autorest.Sender
+	window       time.Duration
+	initialDelay time.Duration
+}
+
+func (s rolePropagationRetrySender) Do(r *http.Request) (*http.Response, error) {
+	// the retries are bounded by both the configured window and the timeout of the operation
+	deadline := time.Now().Add(s.window)
+	if ctxDeadline, ok := r.Context().Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+
+	// autorest doesn't set GetBody for the bodies it prepares (e.g. using WithXML or WithJSON), so the body is
+	// buffered here so that it can be replayed for each retry
+	if r.Body != nil && r.Body != http.NoBody && r.GetBody == nil {
+		body, err := io.ReadAll(r.Body)
+		r.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		r.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
+	}
+
+	delay := s.initialDelay
+	for attempt := 1; ; attempt++ {
+		resp, err := s.sender.Do(r)
+		if err != nil || !authorizationPermissionMismatch(resp) {
+			return resp, err
+		}
+
+		if time.Now().Add(delay).After(deadline) {
+			return resp, err
+		}
+
+		log.Printf("[DEBUG] Storage Data Plane request %s %s returned an AuthorizationPermissionMismatch (attempt %d) - assuming the Role Assignment is still propagating and retrying in %s..", r.Method, r.URL.Redacted(), attempt, delay)
+		select {
+		case <-r.Context().Done():
+			return resp, err
+		case <-time.After(delay):
+		}
+
+		if resp.Body != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		if r.GetBody != nil {
+			body, bodyErr := r.GetBody()
+			if bodyErr != nil {
+				return nil, bodyErr
+			}
+			r.Body = body
+		}
+
+		delay *= 2
+		if delay > rolePropagationRetryMaximumDelay {
+			delay = rolePropagationRetryMaximumDelay
+		}
+	}
+}
+
+// authorizationPermissionMismatch returns whether the response indicates the Azure AD principal doesn't (yet) have
+// a Role Assignment granting access to perform this operation
+func authorizationPermissionMismatch(resp *http.Response) bool {
+	return resp != nil && resp.StatusCode == http.StatusForbidden && strings.EqualFold(resp.Header.Get("x-ms-error-code"), "AuthorizationPermissionMismatch")
+}
@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestRolePropagationRetrySender(t *testing.T) {
+	testData := []struct {
+		name             string
+		errorCode        string
+		failures         int
+		window           time.Duration
+		expectedAttempts int
+		expectedStatus   int
+	}{
+		{
+			name:             "succeeds",
+			failures:         0,
+			window:           time.Second,
+			expectedAttempts: 1,
+			expectedStatus:   http.StatusOK,
+		},
+		{
+			name:             "succeeds once propagated",
+			errorCode:        "AuthorizationPermissionMismatch",
+			failures:         2,
+			window:           time.Second,
+			expectedAttempts: 3,
+			expectedStatus:   http.StatusOK,
+		},
+		{
+			name:             "other authorization error isn't retried",
+			errorCode:        "AuthorizationFailure",
+			failures:         2,
+			window:           time.Second,
+			expectedAttempts: 1,
+			expectedStatus:   http.StatusForbidden,
+		},
+		{
+			name:             "window exceeded",
+			errorCode:        "AuthorizationPermissionMismatch",
+			failures:         100,
+			window:           50 * time.Millisecond,
+			expectedAttempts: 3,
+			expectedStatus:   http.StatusForbidden,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		attempts := 0
+		bodies := make([]string, 0)
+		sender := rolePropagationRetrySender{
+			sender: autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+				attempts++
+				if r.Body != nil {
+					body, _ := io.ReadAll(r.Body)
+					bodies = append(bodies, string(body))
+				}
+
+				if attempts <= v.failures {
+					return &http.Response{
+						StatusCode: http.StatusForbidden,
+						Header: http.Header{
+							"X-Ms-Error-Code": []string{v.errorCode},
+						},
+						Body: io.NopCloser(strings.NewReader("")),
+					}, nil
+				}
+
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader("")),
+				}, nil
+			}),
+			window:       v.window,
+			initialDelay: 10 * time.Millisecond,
+		}
+
+		req, _ := http.NewRequest(http.MethodPut, "https://example.blob.core.windows.net/container?restype=container", strings.NewReader("hello"))
+		resp, err := sender.Do(req)
+		if err != nil {
+			t.Fatalf("sending request: %+v", err)
+		}
+
+		if resp.StatusCode != v.expectedStatus {
+			t.Fatalf("expected the status to be %d but got %d", v.expectedStatus, resp.StatusCode)
+		}
+		if attempts != v.expectedAttempts {
+			t.Fatalf("expected %d attempts but got %d", v.expectedAttempts, attempts)
+		}
+		// the request body should be replayed for each attempt
+		for _, body := range bodies {
+			if body != "hello" {
+				t.Fatalf("expected the body to be %q but got %q", "hello", body)
+			}
+		}
+	}
+}
+
+func TestRolePropagationRetrySenderReplaysPreparedBody(t *testing.T) {
+	attempts := 0
+	bodies := make([]string, 0)
+	sender := rolePropagationRetrySender{
+		sender: autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			attempts++
+			body, _ := io.ReadAll(r.Body)
+			bodies = append(bodies, string(body))
+
+			if attempts <= 2 {
+				return &http.Response{
+					StatusCode: http.StatusForbidden,
+					Header: http.Header{
+						"X-Ms-Error-Code": []string{"AuthorizationPermissionMismatch"},
+					},
+					Body: io.NopCloser(strings.NewReader("")),
+				}, nil
+			}
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader("")),
+			}, nil
+		}),
+		window:       time.Second,
+		initialDelay: 10 * time.Millisecond,
+	}
+
+	// autorest doesn't set GetBody for the bodies it prepares
+	req, err := autorest.Prepare(&http.Request{}, autorest.AsPut(), autorest.WithBaseURL("https://example.queue.core.windows.net/queue1?comp=acl"), autorest.WithString("<SignedIdentifiers />"))
+	if err != nil {
+		t.Fatalf("preparing request: %+v", err)
+	}
+	if req.GetBody != nil {
+		t.Fatalf("expected the prepared request not to support replaying the body")
+	}
+
+	resp, err := sender.Do(req)
+	if err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected the status to be %d but got %d", http.StatusOK, resp.StatusCode)
+	}
+	if attempts != 3 {
+		t.Fatalf("expected 3 attempts but got %d", attempts)
+	}
+	for _, body := range bodies {
+		if body != "<SignedIdentifiers />" {
+			t.Fatalf("expected the body to be %q but got %q", "<SignedIdentifiers />", body)
+		}
+	}
+}
+
+func TestRetryDuringRolePropagation(t *testing.T) {
+	var authorizer autorest.Authorizer = autorest.NullAuthorizer{}
+	testData := []struct {
+		name     string
+		client   Client
+		expected bool
+	}{
+		{
+			name:     "disabled",
+			client:   Client{storageAdAuth: &authorizer},
+			expected: false,
+		},
+		{
+			name:     "shared key",
+			client:   Client{rolePropagationRetryWindow: time.Minute},
+			expected: false,
+		},
+		{
+			name:     "azure ad",
+			client:   Client{storageAdAuth: &authorizer, rolePropagationRetryWindow: time.Minute},
+			expected: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		c := autorest.Client{Sender: &http.Client{}}
+		v.client.retryDuringRolePropagation(&c)
+		if _, actual := c.Sender.(rolePropagationRetrySender); actual != v.expected {
+			t.Fatalf("expected the Sender to be retried to be %t but got %t", v.expected, actual)
+		}
+	}
+}
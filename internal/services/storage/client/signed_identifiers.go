@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// SignedIdentifier is a Stored Access Policy (ACL) for a Queue, Share or Table, which is used to determine which of
+// the Stored Access Policies sent in a Set ACL request was rejected by the Storage Service
+type SignedIdentifier struct {
+	Id         string
+	Start      string
+	Expiry     string
+	Permission string
+}
+
+// signedIdentifierErrorResponse is the subset of the error returned from a Set ACL request which identifies the
+// value that was rejected, e.g. `<XmlNodeName>Permission</XmlNodeName><XmlNodeValue>rwz</XmlNodeValue>`
+type signedIdentifierErrorResponse struct {
+	Code         string `xml:"Code"`
+	XmlNodeName  string `xml:"XmlNodeName"`
+	XmlNodeValue string `xml:"XmlNodeValue"`
+}
+
+// RejectedSignedIdentifierError returns an error identifying the Stored Access Policies which were rejected by the
+// Storage Service when replacing the ACL's, since the Set ACL operation replaces all of these at once and otherwise
+// fails with an error which doesn't include the `id` of the offending Stored Access Policy. Where this can't be
+// determined from the error returned by the Storage Service the original error is returned as-is.
+func RejectedSignedIdentifierError(err error, identifiers []SignedIdentifier) error {
+	detailed, ok := err.(autorest.DetailedError)
+	if !ok || detailed.Response == nil || detailed.Response.Body == nil {
+		return err
+	}
+
+	body, readErr := io.ReadAll(detailed.Response.Body)
+	detailed.Response.Body.Close()
+	detailed.Response.Body = io.NopCloser(bytes.NewReader(body))
+	if readErr != nil || len(body) == 0 {
+		return err
+	}
+
+	var response signedIdentifierErrorResponse
+	if xml.Unmarshal(body, &response) != nil || response.XmlNodeName == "" {
+		return err
+	}
+
+	ids := make([]string, 0)
+	for _, identifier := range identifiers {
+		var value string
+		switch strings.ToLower(response.XmlNodeName) {
+		case "id":
+			value = identifier.Id
+		case "start":
+			value = identifier.Start
+		case "expiry":
+			value = identifier.Expiry
+		case "permission":
+			value = identifier.Permission
+		default:
+			return err
+		}
+
+		if value == response.XmlNodeValue {
+			ids = append(ids, identifier.Id)
+		}
+	}
+	if len(ids) == 0 {
+		return err
+	}
+
+	return fmt.Errorf("the Stored Access Policy with the `id` %q was rejected since the value %q for `%s` isn't valid (%s): %+v", strings.Join(ids, `", "`), response.XmlNodeValue, strings.ToLower(response.XmlNodeName), response.Code, err)
+}
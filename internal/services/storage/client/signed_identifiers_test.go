@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestRejectedSignedIdentifierError(t *testing.T) {
+	identifiers := []SignedIdentifier{
+		{
+			Id:         "first",
+			Start:      "2023-07-01T00:00:00.0000000Z",
+			Expiry:     "2023-08-01T00:00:00.0000000Z",
+			Permission: "r",
+		},
+		{
+			Id:         "second",
+			Start:      "2023-07-01T00:00:00.0000000Z",
+			Expiry:     "2023-09-01T00:00:00.0000000Z",
+			Permission: "rwz",
+		},
+	}
+
+	testData := []struct {
+		name     string
+		err      error
+		expected []string
+	}{
+		{
+			name: "not a detailed error",
+			err:  fmt.Errorf("something went wrong"),
+		},
+		{
+			name: "no details of the rejected value",
+			err:  testSignedIdentifierError(`<?xml version="1.0" encoding="utf-8"?><Error><Code>InvalidXmlDocument</Code><Message>XML specified is not syntactically valid.</Message></Error>`),
+		},
+		{
+			name:     "invalid permission",
+			err:      testSignedIdentifierError(`<?xml version="1.0" encoding="utf-8"?><Error><Code>InvalidXmlNodeValue</Code><Message>The value for one of the XML nodes is not in the correct format.</Message><XmlNodeName>Permission</XmlNodeName><XmlNodeValue>rwz</XmlNodeValue></Error>`),
+			expected: []string{"second"},
+		},
+		{
+			name:     "value shared by multiple Stored Access Policies",
+			err:      testSignedIdentifierError(`<?xml version="1.0" encoding="utf-8"?><Error><Code>InvalidXmlNodeValue</Code><Message>The value for one of the XML nodes is not in the correct format.</Message><XmlNodeName>Start</XmlNodeName><XmlNodeValue>2023-07-01T00:00:00.0000000Z</XmlNodeValue></Error>`),
+			expected: []string{"first", "second"},
+		},
+		{
+			name: "value not matching a Stored Access Policy",
+			err:  testSignedIdentifierError(`<?xml version="1.0" encoding="utf-8"?><Error><Code>InvalidXmlNodeValue</Code><Message>The value for one of the XML nodes is not in the correct format.</Message><XmlNodeName>Expiry</XmlNodeName><XmlNodeValue>tomorrow</XmlNodeValue></Error>`),
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		actual := RejectedSignedIdentifierError(v.err, identifiers)
+		if len(v.expected) == 0 {
+			if actual.Error() != v.err.Error() {
+				t.Fatalf("expected the original error to be returned but got %+v", actual)
+			}
+			continue
+		}
+
+		expected := fmt.Sprintf("the Stored Access Policy with the `id` %q was rejected", strings.Join(v.expected, `", "`))
+		if !strings.HasPrefix(actual.Error(), expected) {
+			t.Fatalf("expected the error to start with %q but got %q", expected, actual.Error())
+		}
+	}
+}
+
+func testSignedIdentifierError(body string) error {
+	resp := &http.Response{
+		StatusCode: http.StatusBadRequest,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+	return autorest.NewErrorWithError(fmt.Errorf("bad request"), "client.Testing", "SetACL", resp, "Failure responding to request")
+}
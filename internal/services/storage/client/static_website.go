@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"log"
+
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/blob/accounts"
+)
+
+// StaticWebsite returns the Static Website settings for the Storage Account (which is served from the `$web`
+// Container), or nil when the Static Website isn't enabled. These settings are only available from the Data Plane
+// API, so when `storage_use_resource_manager_for_containers` is enabled these aren't retrieved (and nil is returned)
+// such that reading a Container never requires access to the Data Plane API.
+func (client Client) StaticWebsite(ctx context.Context, account accountDetails) (*accounts.StaticWebsite, error) {
+	if client.useResourceManagerForContainers {
+		log.Printf("[DEBUG] Skipping retrieving the Static Website settings for Storage Account %q since `storage_use_resource_manager_for_containers` is enabled", account.name)
+		return nil, nil
+	}
+
+	accountsClient, err := client.AccountsDataPlaneClient(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := accountsClient.GetServiceProperties(ctx, account.name)
+	if err != nil {
+		return nil, err
+	}
+
+	if props := resp.StorageServiceProperties; props != nil && props.StaticWebsite != nil && props.StaticWebsite.Enabled {
+		return props.StaticWebsite, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,27 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"testing"
+)
+
+func TestStaticWebsiteUsingResourceManagerForContainers(t *testing.T) {
+	// no Accounts Client or Authorizer is configured, so this would panic if the Data Plane API was used
+	client := Client{
+		useResourceManagerForContainers: true,
+	}
+	account := accountDetails{
+		name: "example",
+	}
+
+	actual, err := client.StaticWebsite(context.Background(), account)
+	if err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+	if actual != nil {
+		t.Fatalf("expected no Static Website settings but got %+v", *actual)
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/autorest/validation"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/table/tables"
+)
+
+// TableACLClient replaces the Stored Access Policies (ACL's) for a Storage Table. The Tables Client always sends
+// the `Start` and `Expiry` elements, whereas these are omitted here when empty - which is how the Table Service
+// expects an Access Policy without a Start (meaning it's valid immediately) to be sent.
+type TableACLClient struct {
+	dataPlaneClient
+}
+
+type tableSignedIdentifier struct {
+	Id           string            `xml:"Id"`
+	AccessPolicy tableAccessPolicy `xml:"AccessPolicy"`
+}
+
+type tableAccessPolicy struct {
+	Start      string `xml:"Start,omitempty"`
+	Expiry     string `xml:"Expiry,omitempty"`
+	Permission string `xml:"Permission"`
+}
+
+type setTableACL struct {
+	SignedIdentifiers []tableSignedIdentifier `xml:"SignedIdentifier"`
+
+	XMLName xml.Name `xml:"SignedIdentifiers"`
+}
+
+func (client Client) TableACLClient(ctx context.Context, account accountDetails) (*TableACLClient, error) {
+	// the Tables Client is built in the same manner so that this is authorized and configured in the same way
+	tablesClient, err := client.tablesDataPlaneClient(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TableACLClient{
+		dataPlaneClient: newDataPlaneClient(tablesClient.Client, tablesClient.BaseURI),
+	}, nil
+}
+
+// SetACL replaces the Stored Access Policies for the specified Storage Table
+func (client TableACLClient) SetACL(ctx context.Context, accountName, tableName string, acls []tables.SignedIdentifier) (result autorest.Response, err error) {
+	if accountName == "" {
+		return result, validation.NewError("client.TableACLClient", "SetACL", "`accountName` cannot be an empty string.")
+	}
+	if tableName == "" {
+		return result, validation.NewError("client.TableACLClient", "SetACL", "`tableName` cannot be an empty string.")
+	}
+
+	input := setTableACL{
+		SignedIdentifiers: make([]tableSignedIdentifier, 0),
+	}
+	for _, acl := range acls {
+		input.SignedIdentifiers = append(input.SignedIdentifiers, tableSignedIdentifier{
+			Id: acl.Id,
+			AccessPolicy: tableAccessPolicy{
+				Start:      acl.AccessPolicy.Start,
+				Expiry:     acl.AccessPolicy.Expiry,
+				Permission: acl.AccessPolicy.Permission,
+			},
+		})
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/xml; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(client.endpoint(accountName, "table")),
+		autorest.WithPathParameters("/{tableName}", map[string]interface{}{
+			"tableName": autorest.Encode("path", tableName),
+		}),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"comp": autorest.Encode("query", "acl"),
+		}),
+		autorest.WithHeaders(map[string]interface{}{
+			"x-ms-version": tables.APIVersion,
+		}),
+		autorest.WithXML(&input))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "client.TableACLClient", "SetACL", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := autorest.SendWithSender(client, req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		result = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "client.TableACLClient", "SetACL", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusNoContent),
+		autorest.ByClosing())
+	result = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "client.TableACLClient", "SetACL", resp, "Failure responding to request")
+	}
+	return
+}
@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/table/tables"
+)
+
+func TestTableACLClientSetACL(t *testing.T) {
+	var actual *http.Request
+	var actualBody string
+	client := TableACLClient{
+		dataPlaneClient: testDataPlaneClient(func(r *http.Request) *http.Response {
+			actual = r
+			body, _ := io.ReadAll(r.Body)
+			actualBody = string(body)
+			return &http.Response{
+				Request:    r,
+				StatusCode: http.StatusNoContent,
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader("")),
+			}
+		}),
+	}
+
+	input := []tables.SignedIdentifier{
+		{
+			Id: "withStart",
+			AccessPolicy: tables.AccessPolicy{
+				Start:      "2023-01-01T00:00:00.0000000Z",
+				Expiry:     "2099-01-01T00:00:00.0000000Z",
+				Permission: "raud",
+			},
+		},
+		{
+			Id: "withoutStart",
+			AccessPolicy: tables.AccessPolicy{
+				Expiry:     "2099-01-01T00:00:00.0000000Z",
+				Permission: "r",
+			},
+		},
+	}
+	if _, err := client.SetACL(context.Background(), "example", "table1", input); err != nil {
+		t.Fatalf("setting ACL's: %+v", err)
+	}
+
+	if actual.Method != http.MethodPut {
+		t.Fatalf("expected the method to be %q but got %q", http.MethodPut, actual.Method)
+	}
+	if actual.URL.Host != "example.table.core.windows.net" || actual.URL.Path != "/table1" {
+		t.Fatalf("expected the URI to be %q but got %q", "https://example.table.core.windows.net/table1", actual.URL.String())
+	}
+	if actual.URL.Query().Get("comp") != "acl" {
+		t.Fatalf("expected the query to be %q but got %q", "comp=acl", actual.URL.RawQuery)
+	}
+
+	expected := `<SignedIdentifiers><SignedIdentifier><Id>withStart</Id><AccessPolicy><Start>2023-01-01T00:00:00.0000000Z</Start><Expiry>2099-01-01T00:00:00.0000000Z</Expiry><Permission>raud</Permission></AccessPolicy></SignedIdentifier><SignedIdentifier><Id>withoutStart</Id><AccessPolicy><Expiry>2099-01-01T00:00:00.0000000Z</Expiry><Permission>r</Permission></AccessPolicy></SignedIdentifier></SignedIdentifiers>`
+	if !strings.HasSuffix(actualBody, expected) {
+		t.Fatalf("expected the body to end with %q but got %q", expected, actualBody)
+	}
+	if strings.Contains(actualBody, "<Start></Start>") {
+		t.Fatalf("expected an empty Start to be omitted but got %q", actualBody)
+	}
+}
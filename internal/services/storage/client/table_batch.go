@@ -0,0 +1,232 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/autorest/validation"
+	"github.com/hashicorp/go-uuid"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/table/entities"
+)
+
+// TableBatchMaxOperations is the maximum number of operations which can be submitted in a single Entity Group
+// Transaction (batch) against a Storage Table
+const TableBatchMaxOperations = 100
+
+// TableBatchClient submits Entity Group Transactions (batches) against a Storage Table, since these operations
+// aren't available in the Entities Client.
+type TableBatchClient struct {
+	dataPlaneClient
+}
+
+func (client Client) TableBatchClient(ctx context.Context, account accountDetails) (*TableBatchClient, error) {
+	// the Entities Client is built in the same manner so that this is authorized and configured in the same way
+	entitiesClient, err := client.TableEntityClient(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TableBatchClient{
+		dataPlaneClient: newDataPlaneClient(entitiesClient.Client, entitiesClient.BaseURI),
+	}, nil
+}
+
+// InsertOrReplaceEntities inserts or replaces the specified Entities within a single Entity Group Transaction, as
+// such all of the Entities must have the same Partition Key and a maximum of 100 Entities can be specified
+func (client TableBatchClient) InsertOrReplaceEntities(ctx context.Context, accountName, tableName string, input []map[string]interface{}) (result autorest.Response, err error) {
+	return client.submit(ctx, "InsertOrReplaceEntities", http.MethodPut, accountName, tableName, input)
+}
+
+// DeleteEntities deletes the specified Entities (identified by their `PartitionKey` and `RowKey`) within a single
+// Entity Group Transaction, as such all of the Entities must have the same Partition Key and a maximum of 100
+// Entities can be specified. Should any of these Entities not exist the Changeset fails and none are deleted.
+func (client TableBatchClient) DeleteEntities(ctx context.Context, accountName, tableName string, input []map[string]interface{}) (result autorest.Response, err error) {
+	return client.submit(ctx, "DeleteEntities", http.MethodDelete, accountName, tableName, input)
+}
+
+func (client TableBatchClient) submit(ctx context.Context, operation, method, accountName, tableName string, input []map[string]interface{}) (result autorest.Response, err error) {
+	if accountName == "" {
+		return result, validation.NewError("client.TableBatchClient", operation, "`accountName` cannot be an empty string.")
+	}
+	if tableName == "" {
+		return result, validation.NewError("client.TableBatchClient", operation, "`tableName` cannot be an empty string.")
+	}
+	if len(input) == 0 {
+		return result, validation.NewError("client.TableBatchClient", operation, "at least one Entity must be specified.")
+	}
+	if len(input) > TableBatchMaxOperations {
+		return result, validation.NewError("client.TableBatchClient", operation, fmt.Sprintf("a maximum of %d Entities can be specified in a batch.", TableBatchMaxOperations))
+	}
+
+	batchId, err := uuid.GenerateUUID()
+	if err != nil {
+		return result, fmt.Errorf("generating Batch ID: %+v", err)
+	}
+	changesetId, err := uuid.GenerateUUID()
+	if err != nil {
+		return result, fmt.Errorf("generating Changeset ID: %+v", err)
+	}
+
+	body, err := client.batchBody(accountName, tableName, "batch_"+batchId, "changeset_"+changesetId, method, input)
+	if err != nil {
+		return result, validation.NewError("client.TableBatchClient", operation, err.Error())
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType(fmt.Sprintf("multipart/mixed; boundary=batch_%s", batchId)),
+		autorest.AsPost(),
+		autorest.WithBaseURL(client.endpoint(accountName, "table")),
+		autorest.WithPath("/$batch"),
+		autorest.WithHeaders(map[string]interface{}{
+			"x-ms-version":          entities.APIVersion,
+			"Accept-Charset":        "UTF-8",
+			"DataServiceVersion":    "3.0;",
+			"MaxDataServiceVersion": "3.0;NetFx",
+		}),
+		autorest.WithString(body))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "client.TableBatchClient", operation, nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := autorest.SendWithSender(client, req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		result = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "client.TableBatchClient", operation, resp, "Failure sending request")
+		return
+	}
+
+	// the Batch itself returns a 202 when it's processed, with the outcome of the Changeset contained in the body
+	var responseBody []byte
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusAccepted),
+		autorest.ByUnmarshallingBytes(&responseBody),
+		autorest.ByClosing())
+	result = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "client.TableBatchClient", operation, resp, "Failure responding to request")
+		return
+	}
+
+	if err = parseTableBatchResponse(string(responseBody)); err != nil {
+		err = autorest.NewErrorWithError(err, "client.TableBatchClient", operation, resp, "Failure processing batch")
+	}
+	return
+}
+
+// batchBody returns the multipart body for a Batch containing a single Changeset, with an operation using the
+// specified method for each Entity - the Entity is only sent as the payload for a PUT
+func (client TableBatchClient) batchBody(accountName, tableName, batchBoundary, changesetBoundary, method string, input []map[string]interface{}) (string, error) {
+	var partitionKey string
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "--%s\r\n", batchBoundary)
+	fmt.Fprintf(&b, "Content-Type: multipart/mixed; boundary=%s\r\n\r\n", changesetBoundary)
+
+	for i, entity := range input {
+		entityPartitionKey, _ := entity["PartitionKey"].(string)
+		rowKey, _ := entity["RowKey"].(string)
+		if entityPartitionKey == "" || rowKey == "" {
+			return "", fmt.Errorf("the Entity at index %d must have a `PartitionKey` and `RowKey`", i)
+		}
+		if i == 0 {
+			partitionKey = entityPartitionKey
+		}
+		if entityPartitionKey != partitionKey {
+			return "", fmt.Errorf("all Entities in a batch must have the same Partition Key but got %q and %q", partitionKey, entityPartitionKey)
+		}
+
+		uri := fmt.Sprintf("%s/%s(PartitionKey='%s',RowKey='%s')", client.endpoint(accountName, "table"), url.PathEscape(tableName), tableKeyEscape(entityPartitionKey), tableKeyEscape(rowKey))
+
+		fmt.Fprintf(&b, "--%s\r\n", changesetBoundary)
+		b.WriteString("Content-Type: application/http\r\n")
+		b.WriteString("Content-Transfer-Encoding: binary\r\n\r\n")
+		fmt.Fprintf(&b, "%s %s HTTP/1.1\r\n", method, uri)
+
+		if method == http.MethodDelete {
+			b.WriteString("Accept: application/json;odata=minimalmetadata\r\n")
+			b.WriteString("If-Match: *\r\n")
+			b.WriteString("DataServiceVersion: 3.0;\r\n\r\n")
+			continue
+		}
+
+		payload, err := json.Marshal(entity)
+		if err != nil {
+			return "", fmt.Errorf("serializing the Entity at index %d: %+v", i, err)
+		}
+
+		b.WriteString("Content-Type: application/json\r\n")
+		b.WriteString("Accept: application/json;odata=minimalmetadata\r\n")
+		b.WriteString("Prefer: return-no-content\r\n")
+		b.WriteString("DataServiceVersion: 3.0;\r\n\r\n")
+		b.Write(payload)
+		b.WriteString("\r\n")
+	}
+
+	fmt.Fprintf(&b, "--%s--\r\n\r\n", changesetBoundary)
+	fmt.Fprintf(&b, "--%s--\r\n", batchBoundary)
+
+	return b.String(), nil
+}
+
+// tableKeyEscape escapes a Partition Key or Row Key for use within the URI of an Entity
+func tableKeyEscape(input string) string {
+	return url.PathEscape(strings.ReplaceAll(input, "'", "''"))
+}
+
+// parseTableBatchResponse returns an error if any of the responses within the body of a Batch response
+// indicate the Changeset failed - in which case none of the operations within the Changeset were applied
+func parseTableBatchResponse(body string) error {
+	scanner := bufio.NewScanner(strings.NewReader(body))
+	scanner.Buffer(make([]byte, 0, 64*1024), len(body)+1)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !strings.HasPrefix(line, "HTTP/1.1 ") {
+			continue
+		}
+
+		segments := strings.SplitN(line, " ", 3)
+		statusCode, err := strconv.Atoi(segments[1])
+		if err != nil {
+			return fmt.Errorf("parsing the status code from %q: %+v", line, err)
+		}
+		if statusCode >= 200 && statusCode < 300 {
+			continue
+		}
+
+		// the error details follow the headers for this response, up until the next boundary
+		details := make([]string, 0)
+		inBody := false
+		for scanner.Scan() {
+			detail := strings.TrimSpace(scanner.Text())
+			if strings.HasPrefix(detail, "--") {
+				break
+			}
+			if !inBody {
+				inBody = detail == ""
+				continue
+			}
+			if detail != "" {
+				details = append(details, detail)
+			}
+		}
+		return fmt.Errorf("the batch failed with %q: %s", strings.TrimPrefix(line, "HTTP/1.1 "), strings.Join(details, " "))
+	}
+
+	return scanner.Err()
+}
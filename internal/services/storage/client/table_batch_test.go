@@ -0,0 +1,233 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestTableBatchClientInsertOrReplaceEntities(t *testing.T) {
+	var actual *http.Request
+	var actualBody string
+	client := TableBatchClient{
+		dataPlaneClient: testDataPlaneClient(func(r *http.Request) *http.Response {
+			actual = r
+			body, _ := io.ReadAll(r.Body)
+			actualBody = string(body)
+			return &http.Response{
+				Request:    r,
+				StatusCode: http.StatusAccepted,
+				Header:     http.Header{},
+				Body: io.NopCloser(strings.NewReader(strings.Join([]string{
+					"--batchresponse_1",
+					"Content-Type: multipart/mixed; boundary=changesetresponse_1",
+					"",
+					"--changesetresponse_1",
+					"Content-Type: application/http",
+					"Content-Transfer-Encoding: binary",
+					"",
+					"HTTP/1.1 204 No Content",
+					"",
+					"--changesetresponse_1--",
+					"--batchresponse_1--",
+				}, "\r\n"))),
+			}
+		}),
+	}
+
+	input := []map[string]interface{}{
+		{
+			"PartitionKey": "partition1",
+			"RowKey":       "row1",
+			"Name":         "first",
+		},
+		{
+			"PartitionKey": "partition1",
+			"RowKey":       "o'brien",
+			"Name":         "second",
+		},
+	}
+	if _, err := client.InsertOrReplaceEntities(context.Background(), "example", "table1", input); err != nil {
+		t.Fatalf("inserting Entities: %+v", err)
+	}
+
+	if actual.Method != http.MethodPost {
+		t.Fatalf("expected the method to be %q but got %q", http.MethodPost, actual.Method)
+	}
+	if actual.URL.Host != "example.table.core.windows.net" || actual.URL.Path != "/$batch" {
+		t.Fatalf("expected the URL to be %q but got %q", "example.table.core.windows.net/$batch", actual.URL.Host+actual.URL.Path)
+	}
+	if contentType := actual.Header.Get("Content-Type"); !strings.HasPrefix(contentType, "multipart/mixed; boundary=batch_") {
+		t.Fatalf("expected a multipart Content-Type but got %q", contentType)
+	}
+	for _, expected := range []string{
+		"PUT https://example.table.core.windows.net/table1(PartitionKey='partition1',RowKey='row1') HTTP/1.1",
+		"PUT https://example.table.core.windows.net/table1(PartitionKey='partition1',RowKey='o%27%27brien') HTTP/1.1",
+		`"Name":"first"`,
+		`"Name":"second"`,
+	} {
+		if !strings.Contains(actualBody, expected) {
+			t.Fatalf("expected the body to contain %q but got %q", expected, actualBody)
+		}
+	}
+}
+
+func TestTableBatchClientDeleteEntities(t *testing.T) {
+	var actualBody string
+	client := TableBatchClient{
+		dataPlaneClient: testDataPlaneClient(func(r *http.Request) *http.Response {
+			body, _ := io.ReadAll(r.Body)
+			actualBody = string(body)
+			return &http.Response{
+				Request:    r,
+				StatusCode: http.StatusAccepted,
+				Header:     http.Header{},
+				Body: io.NopCloser(strings.NewReader(strings.Join([]string{
+					"--batchresponse_1",
+					"Content-Type: multipart/mixed; boundary=changesetresponse_1",
+					"",
+					"--changesetresponse_1",
+					"Content-Type: application/http",
+					"Content-Transfer-Encoding: binary",
+					"",
+					"HTTP/1.1 204 No Content",
+					"",
+					"--changesetresponse_1--",
+					"--batchresponse_1--",
+				}, "\r\n"))),
+			}
+		}),
+	}
+
+	input := []map[string]interface{}{
+		{
+			"PartitionKey": "partition1",
+			"RowKey":       "row1",
+		},
+		{
+			"PartitionKey": "partition1",
+			"RowKey":       "row2",
+		},
+	}
+	if _, err := client.DeleteEntities(context.Background(), "example", "table1", input); err != nil {
+		t.Fatalf("deleting Entities: %+v", err)
+	}
+
+	for _, expected := range []string{
+		"DELETE https://example.table.core.windows.net/table1(PartitionKey='partition1',RowKey='row1') HTTP/1.1",
+		"DELETE https://example.table.core.windows.net/table1(PartitionKey='partition1',RowKey='row2') HTTP/1.1",
+		"If-Match: *",
+	} {
+		if !strings.Contains(actualBody, expected) {
+			t.Fatalf("expected the body to contain %q but got %q", expected, actualBody)
+		}
+	}
+	if strings.Contains(actualBody, "PartitionKey\"") {
+		t.Fatalf("expected no Entity payload to be sent but got %q", actualBody)
+	}
+}
+
+func TestTableBatchClientInsertOrReplaceEntitiesInvalid(t *testing.T) {
+	client := TableBatchClient{
+		dataPlaneClient: testDataPlaneClient(func(r *http.Request) *http.Response {
+			t.Fatalf("expected no request to be sent")
+			return nil
+		}),
+	}
+
+	testData := []struct {
+		name  string
+		input []map[string]interface{}
+	}{
+		{
+			name:  "none",
+			input: []map[string]interface{}{},
+		},
+		{
+			name: "multiple partitions",
+			input: []map[string]interface{}{
+				{"PartitionKey": "partition1", "RowKey": "row1"},
+				{"PartitionKey": "partition2", "RowKey": "row1"},
+			},
+		},
+		{
+			name: "missing row key",
+			input: []map[string]interface{}{
+				{"PartitionKey": "partition1"},
+			},
+		},
+		{
+			name: "too many",
+			input: func() []map[string]interface{} {
+				output := make([]map[string]interface{}, 0)
+				for i := 0; i <= TableBatchMaxOperations; i++ {
+					output = append(output, map[string]interface{}{"PartitionKey": "partition1", "RowKey": strings.Repeat("a", i+1)})
+				}
+				return output
+			}(),
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		if _, err := client.InsertOrReplaceEntities(context.Background(), "example", "table1", v.input); err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+}
+
+func TestParseTableBatchResponse(t *testing.T) {
+	testData := []struct {
+		name     string
+		body     string
+		expected string
+	}{
+		{
+			name: "success",
+			body: strings.Join([]string{
+				"--changesetresponse_1",
+				"HTTP/1.1 204 No Content",
+				"",
+				"--changesetresponse_1",
+				"HTTP/1.1 204 No Content",
+				"",
+				"--changesetresponse_1--",
+			}, "\r\n"),
+		},
+		{
+			name: "failure",
+			body: strings.Join([]string{
+				"--changesetresponse_1",
+				"Content-Type: application/http",
+				"",
+				"HTTP/1.1 400 Bad Request",
+				"Content-Type: application/json;odata=minimalmetadata;charset=utf-8",
+				"",
+				`{"odata.error":{"code":"InvalidInput","message":{"value":"1:One of the request inputs is not valid."}}}`,
+				"--changesetresponse_1--",
+			}, "\r\n"),
+			expected: `the batch failed with "400 Bad Request": {"odata.error":{"code":"InvalidInput","message":{"value":"1:One of the request inputs is not valid."}}}`,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		err := parseTableBatchResponse(v.body)
+		if v.expected == "" {
+			if err != nil {
+				t.Fatalf("expected no error but got: %+v", err)
+			}
+			continue
+		}
+		if err == nil || err.Error() != v.expected {
+			t.Fatalf("expected the error %q but got %v", v.expected, err)
+		}
+	}
+}
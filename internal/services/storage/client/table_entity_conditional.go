@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/autorest/validation"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/table/entities"
+)
+
+// TableEntityConditionalClient updates an existing Entity only when its ETag matches the specified ETag (using the
+// `If-Match` header), since the Entities Client only supports the Insert Or Merge/Replace operations - which don't
+// support this.
+type TableEntityConditionalClient struct {
+	dataPlaneClient
+}
+
+type ConditionalEntityInput struct {
+	PartitionKey string
+	RowKey       string
+
+	// IfMatch is the ETag which the Entity must currently have for this to be updated, otherwise the Table Service
+	// returns a 412 Precondition Failed
+	IfMatch string
+
+	Entity map[string]interface{}
+}
+
+func (client Client) TableEntityConditionalClient(ctx context.Context, account accountDetails) (*TableEntityConditionalClient, error) {
+	// the Entities Client is built in the same manner so that this is authorized and configured in the same way
+	entitiesClient, err := client.TableEntityClient(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TableEntityConditionalClient{
+		dataPlaneClient: newDataPlaneClient(entitiesClient.Client, entitiesClient.BaseURI),
+	}, nil
+}
+
+// Merge updates the properties of an existing Entity, retaining any properties which aren't specified
+func (client TableEntityConditionalClient) Merge(ctx context.Context, accountName, tableName string, input ConditionalEntityInput) (result autorest.Response, err error) {
+	return client.send(ctx, "Merge", autorest.AsMerge(), accountName, tableName, input)
+}
+
+// Update replaces an existing Entity, removing any properties which aren't specified
+func (client TableEntityConditionalClient) Update(ctx context.Context, accountName, tableName string, input ConditionalEntityInput) (result autorest.Response, err error) {
+	return client.send(ctx, "Update", autorest.AsPut(), accountName, tableName, input)
+}
+
+func (client TableEntityConditionalClient) send(ctx context.Context, operation string, method autorest.PrepareDecorator, accountName, tableName string, input ConditionalEntityInput) (result autorest.Response, err error) {
+	if accountName == "" {
+		return result, validation.NewError("client.TableEntityConditionalClient", operation, "`accountName` cannot be an empty string.")
+	}
+	if tableName == "" {
+		return result, validation.NewError("client.TableEntityConditionalClient", operation, "`tableName` cannot be an empty string.")
+	}
+	if input.PartitionKey == "" {
+		return result, validation.NewError("client.TableEntityConditionalClient", operation, "`input.PartitionKey` cannot be an empty string.")
+	}
+	if input.RowKey == "" {
+		return result, validation.NewError("client.TableEntityConditionalClient", operation, "`input.RowKey` cannot be an empty string.")
+	}
+	if input.IfMatch == "" {
+		return result, validation.NewError("client.TableEntityConditionalClient", operation, "`input.IfMatch` cannot be an empty string.")
+	}
+
+	pathParameters := map[string]interface{}{
+		"tableName":    autorest.Encode("path", tableName),
+		"partitionKey": autorest.Encode("path", strings.ReplaceAll(input.PartitionKey, "'", "''")),
+		"rowKey":       autorest.Encode("path", strings.ReplaceAll(input.RowKey, "'", "''")),
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json"),
+		method,
+		autorest.WithBaseURL(client.endpoint(accountName, "table")),
+		autorest.WithPathParameters("/{tableName}(PartitionKey='{partitionKey}',RowKey='{rowKey}')", pathParameters),
+		autorest.WithJSON(input.Entity),
+		autorest.WithHeaders(map[string]interface{}{
+			"x-ms-version": entities.APIVersion,
+			"Accept":       "application/json",
+			"If-Match":     input.IfMatch,
+		}))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "client.TableEntityConditionalClient", operation, nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := autorest.SendWithSender(client, req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		result = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "client.TableEntityConditionalClient", operation, resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusNoContent),
+		autorest.ByClosing())
+	result = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "client.TableEntityConditionalClient", operation, resp, "Failure responding to request")
+	}
+	return
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestTableEntityConditionalClient(t *testing.T) {
+	testData := []struct {
+		name           string
+		update         bool
+		statusCode     int
+		expectedMethod string
+		expectError    bool
+	}{
+		{
+			name:           "merge",
+			statusCode:     http.StatusNoContent,
+			expectedMethod: "MERGE",
+		},
+		{
+			name:           "update",
+			update:         true,
+			statusCode:     http.StatusNoContent,
+			expectedMethod: http.MethodPut,
+		},
+		{
+			name:           "etag mismatch",
+			statusCode:     http.StatusPreconditionFailed,
+			expectedMethod: "MERGE",
+			expectError:    true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		var actual *http.Request
+		var actualBody string
+		client := TableEntityConditionalClient{
+			dataPlaneClient: testDataPlaneClient(func(r *http.Request) *http.Response {
+				actual = r
+				body, _ := io.ReadAll(r.Body)
+				actualBody = string(body)
+				return &http.Response{
+					Request:    r,
+					StatusCode: v.statusCode,
+					Header:     http.Header{},
+					Body:       io.NopCloser(strings.NewReader("")),
+				}
+			}),
+		}
+
+		input := ConditionalEntityInput{
+			PartitionKey: "partition",
+			RowKey:       "o'brien",
+			IfMatch:      `W/"datetime'2024-01-02T03%3A04%3A05.1234567Z'"`,
+			Entity: map[string]interface{}{
+				"Name": "first",
+			},
+		}
+
+		var resp autorest.Response
+		var err error
+		if v.update {
+			resp, err = client.Update(context.Background(), "example", "table1", input)
+		} else {
+			resp, err = client.Merge(context.Background(), "example", "table1", input)
+		}
+		if v.expectError {
+			if err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			if resp.Response == nil || resp.StatusCode != v.statusCode {
+				t.Fatalf("expected the response to have the status code %d", v.statusCode)
+			}
+		} else if err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+
+		if actual.Method != v.expectedMethod {
+			t.Fatalf("expected the method to be %q but got %q", v.expectedMethod, actual.Method)
+		}
+		if actual.URL.Host != "example.table.core.windows.net" {
+			t.Fatalf("expected the host to be %q but got %q", "example.table.core.windows.net", actual.URL.Host)
+		}
+		if expected := "/table1(PartitionKey='partition',RowKey='o''brien')"; actual.URL.Path != expected {
+			t.Fatalf("expected the path to be %q but got %q", expected, actual.URL.Path)
+		}
+		if actual.Header.Get("If-Match") != input.IfMatch {
+			t.Fatalf("expected the If-Match header to be %q but got %q", input.IfMatch, actual.Header.Get("If-Match"))
+		}
+		if actualBody != `{"Name":"first"}` {
+			t.Fatalf("expected the body to be %q but got %q", `{"Name":"first"}`, actualBody)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/autorest/validation"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/queue/queues"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/table/tables"
+)
+
+// TableServicePropertiesClient manages the CORS Rules, Logging and Metrics for the Table Service using the Data
+// Plane API, since these operations aren't available in the Tables Client. The Table Service uses the same format
+// for these properties as the Queue Service, and so the Queue Service's model is reused.
+type TableServicePropertiesClient struct {
+	dataPlaneClient
+}
+
+type GetTableServicePropertiesResult struct {
+	autorest.Response
+
+	queues.StorageServiceProperties
+}
+
+func (client Client) TableServicePropertiesClient(ctx context.Context, account accountDetails) (*TableServicePropertiesClient, error) {
+	// NOTE: as with the Tables Client, this uses Shared Key authorization rather than AzureAD
+	tablesClient, err := client.tablesDataPlaneClient(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TableServicePropertiesClient{
+		dataPlaneClient: newDataPlaneClient(tablesClient.Client, tablesClient.BaseURI),
+	}, nil
+}
+
+// GetServiceProperties returns the CORS Rules, Logging and Metrics configured for the Table Service
+func (client TableServicePropertiesClient) GetServiceProperties(ctx context.Context, accountName string) (result GetTableServicePropertiesResult, err error) {
+	if accountName == "" {
+		return result, validation.NewError("client.TableServicePropertiesClient", "GetServiceProperties", "`accountName` cannot be an empty string.")
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(client.endpoint(accountName, "table")),
+		autorest.WithPath("/"),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"restype": "service",
+			"comp":    "properties",
+		}),
+		autorest.WithHeaders(map[string]interface{}{
+			"x-ms-version": tables.APIVersion,
+		}))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "client.TableServicePropertiesClient", "GetServiceProperties", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := autorest.SendWithSender(client, req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "client.TableServicePropertiesClient", "GetServiceProperties", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingXML(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "client.TableServicePropertiesClient", "GetServiceProperties", resp, "Failure responding to request")
+	}
+	return
+}
+
+// SetServiceProperties replaces the CORS Rules, Logging and Metrics configured for the Table Service, where any
+// of these which are omitted from `properties` are left unchanged
+func (client TableServicePropertiesClient) SetServiceProperties(ctx context.Context, accountName string, properties queues.StorageServiceProperties) (result autorest.Response, err error) {
+	if accountName == "" {
+		return result, validation.NewError("client.TableServicePropertiesClient", "SetServiceProperties", "`accountName` cannot be an empty string.")
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/xml; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(client.endpoint(accountName, "table")),
+		autorest.WithPath("/"),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"restype": "service",
+			"comp":    "properties",
+		}),
+		autorest.WithXML(properties),
+		autorest.WithHeaders(map[string]interface{}{
+			"x-ms-version": tables.APIVersion,
+		}))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "client.TableServicePropertiesClient", "SetServiceProperties", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := autorest.SendWithSender(client, req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		result = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "client.TableServicePropertiesClient", "SetServiceProperties", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusAccepted),
+		autorest.ByClosing())
+	result = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "client.TableServicePropertiesClient", "SetServiceProperties", resp, "Failure responding to request")
+	}
+	return
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/queue/queues"
+)
+
+func TestTableServicePropertiesClientGetServiceProperties(t *testing.T) {
+	body := `<?xml version="1.0" encoding="utf-8"?><StorageServiceProperties><Logging><Version>1.0</Version><Delete>true</Delete><Read>false</Read><Write>true</Write><RetentionPolicy><Enabled>true</Enabled><Days>7</Days></RetentionPolicy></Logging><HourMetrics><Version>1.0</Version><Enabled>true</Enabled><IncludeAPIs>true</IncludeAPIs><RetentionPolicy><Enabled>false</Enabled></RetentionPolicy></HourMetrics><MinuteMetrics><Version>1.0</Version><Enabled>false</Enabled><RetentionPolicy><Enabled>false</Enabled></RetentionPolicy></MinuteMetrics><Cors><CorsRule><AllowedOrigins>http://www.example.com</AllowedOrigins><AllowedMethods>GET,PUT</AllowedMethods><AllowedHeaders>x-ms-meta-*</AllowedHeaders><ExposedHeaders>x-ms-meta-*</ExposedHeaders><MaxAgeInSeconds>60</MaxAgeInSeconds></CorsRule></Cors></StorageServiceProperties>`
+
+	var actual *http.Request
+	client := TableServicePropertiesClient{
+		dataPlaneClient: testDataPlaneClient(func(r *http.Request) *http.Response {
+			actual = r
+			return &http.Response{
+				Request:    r,
+				StatusCode: http.StatusOK,
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader(body)),
+			}
+		}),
+	}
+
+	result, err := client.GetServiceProperties(context.Background(), "example")
+	if err != nil {
+		t.Fatalf("retrieving Service Properties: %+v", err)
+	}
+
+	if actual.Method != http.MethodGet {
+		t.Fatalf("expected the method to be %q but got %q", http.MethodGet, actual.Method)
+	}
+	if actual.URL.Host != "example.table.core.windows.net" {
+		t.Fatalf("expected the host to be %q but got %q", "example.table.core.windows.net", actual.URL.Host)
+	}
+	if actual.URL.RawQuery != "comp=properties&restype=service" {
+		t.Fatalf("expected the query to be %q but got %q", "comp=properties&restype=service", actual.URL.RawQuery)
+	}
+
+	if logging := result.Logging; logging == nil || !logging.Delete || logging.Read || !logging.Write || logging.RetentionPolicy.Days != 7 {
+		t.Fatalf("expected the Logging to be parsed but got %+v", result.Logging)
+	}
+	if metrics := result.HourMetrics; metrics == nil || !metrics.Enabled || metrics.IncludeAPIs == nil || !*metrics.IncludeAPIs {
+		t.Fatalf("expected the Hour Metrics to be parsed but got %+v", result.HourMetrics)
+	}
+	if metrics := result.MinuteMetrics; metrics == nil || metrics.Enabled {
+		t.Fatalf("expected the Minute Metrics to be parsed but got %+v", result.MinuteMetrics)
+	}
+	if cors := result.Cors; cors == nil || len(cors.CorsRule) != 1 || cors.CorsRule[0].AllowedMethods != "GET,PUT" || cors.CorsRule[0].MaxAgeInSeconds != 60 {
+		t.Fatalf("expected the CORS Rules to be parsed but got %+v", result.Cors)
+	}
+}
+
+func TestTableServicePropertiesClientSetServiceProperties(t *testing.T) {
+	var actual *http.Request
+	var actualBody string
+	client := TableServicePropertiesClient{
+		dataPlaneClient: testDataPlaneClient(func(r *http.Request) *http.Response {
+			actual = r
+			body, _ := io.ReadAll(r.Body)
+			actualBody = string(body)
+			return &http.Response{
+				Request:    r,
+				StatusCode: http.StatusAccepted,
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader("")),
+			}
+		}),
+	}
+
+	input := queues.StorageServiceProperties{
+		Cors: &queues.Cors{},
+		MinuteMetrics: &queues.MetricsConfig{
+			Version: "1.0",
+			Enabled: false,
+		},
+	}
+	if _, err := client.SetServiceProperties(context.Background(), "example", input); err != nil {
+		t.Fatalf("setting Service Properties: %+v", err)
+	}
+
+	if actual.Method != http.MethodPut {
+		t.Fatalf("expected the method to be %q but got %q", http.MethodPut, actual.Method)
+	}
+	if actual.URL.Host != "example.table.core.windows.net" {
+		t.Fatalf("expected the host to be %q but got %q", "example.table.core.windows.net", actual.URL.Host)
+	}
+	if actual.URL.RawQuery != "comp=properties&restype=service" {
+		t.Fatalf("expected the query to be %q but got %q", "comp=properties&restype=service", actual.URL.RawQuery)
+	}
+
+	// an empty `Cors` element clears the CORS Rules, whereas the Logging and Hour Metrics are left unchanged
+	expectedBody := "<StorageServiceProperties><MinuteMetrics><Version>1.0</Version><Enabled>false</Enabled><RetentionPolicy><Enabled>false</Enabled></RetentionPolicy></MinuteMetrics><Cors></Cors></StorageServiceProperties>"
+	if actualBody = strings.TrimPrefix(actualBody, xml.Header); actualBody != expectedBody {
+		t.Fatalf("expected the body to be %q but got %q", expectedBody, actualBody)
+	}
+}
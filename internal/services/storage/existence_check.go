@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// minimumExistenceCheckTimeout is the shortest time allowed for checking whether a resource exists, regardless of
+// the remaining time for the operation
+const minimumExistenceCheckTimeout = 1 * time.Minute
+
+// existenceCheckTimeout returns the time allowed for checking whether a resource exists, being a fifth of the time
+// remaining for the operation - such that a stalled request fails fast, rather than consuming the entire timeout
+func existenceCheckTimeout(remaining time.Duration) time.Duration {
+	timeout := remaining / 5
+	if timeout < minimumExistenceCheckTimeout {
+		timeout = minimumExistenceCheckTimeout
+	}
+	return timeout
+}
+
+// checkExistsWithTimeout runs the specified existence check using a Context limited to a fraction of the time
+// remaining for the operation, returning a meaningful error should the check not complete within this time
+func checkExistsWithTimeout(ctx context.Context, check func(ctx context.Context) (*bool, error)) (*bool, error) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return check(ctx)
+	}
+
+	timeout := existenceCheckTimeout(time.Until(deadline))
+	existsCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	exists, err := check(existsCtx)
+	if err != nil && ctx.Err() == nil && errors.Is(existsCtx.Err(), context.DeadlineExceeded) {
+		return nil, fmt.Errorf("the existence check didn't complete within %s, this can happen when the Storage Account isn't reachable from where Terraform is running (for example due to Network Rules or a Private Endpoint): %+v", timeout, err)
+	}
+
+	return exists, err
+}
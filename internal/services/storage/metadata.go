@@ -41,6 +41,21 @@ func ExpandMetaData(input map[string]interface{}) map[string]string {
 	return output
 }
 
+// metaDataEqual returns whether the two sets of MetaData contain the same keys and values
+func metaDataEqual(first, second map[string]string) bool {
+	if len(first) != len(second) {
+		return false
+	}
+
+	for k, v := range first {
+		if other, ok := second[k]; !ok || other != v {
+			return false
+		}
+	}
+
+	return true
+}
+
 func FlattenMetaData(input map[string]string) map[string]interface{} {
 	output := make(map[string]interface{})
 
@@ -6,8 +6,10 @@ package migration
 import (
 	"context"
 	"fmt"
+	"log"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
@@ -66,3 +68,104 @@ func (ContainerV0ToV1) UpgradeFunc() pluginsdk.StateUpgraderFunc {
 		return rawState, nil
 	}
 }
+
+var _ pluginsdk.StateUpgrade = ContainerV1ToV2{}
+
+type ContainerV1ToV2 struct{}
+
+func (ContainerV1ToV2) Schema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+
+		"storage_account_name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+
+		"container_access_type": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  "private",
+		},
+
+		"metadata": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"authentication_method": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"has_immutability_policy": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
+		"has_legal_hold": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
+		"lease_duration": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"lease_state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"lease_status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"public_access_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
+		"resource_manager_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (ContainerV1ToV2) UpgradeFunc() pluginsdk.StateUpgraderFunc {
+	// very old versions of the Provider used an ID which wasn't a Data Plane URL (e.g. `{name}/{resourceGroup}/{accountName}`),
+	// which may remain in the state if the V0 -> V1 upgrade was never applied - these are rewritten to the current format
+	return func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		id := rawState["id"].(string)
+		// NOTE: legacy IDs don't contain a host, which the parser (leniently) treats as an empty Account Name
+		if parsed, err := parse.StorageContainerDataPlaneID(id); err == nil && parsed.AccountName != "" {
+			return rawState, nil
+		}
+
+		environment := meta.(*clients.Client).Account.Environment
+		storageDomainSuffix, ok := environment.Storage.DomainSuffix()
+		if !ok {
+			return nil, fmt.Errorf("could not determine Storage domain suffix for environment %q", environment.Name)
+		}
+
+		containerName := rawState["name"].(string)
+		storageAccountName := rawState["storage_account_name"].(string)
+		newID := parse.NewStorageContainerDataPlaneId(storageAccountName, *storageDomainSuffix, containerName).ID()
+		log.Printf("[DEBUG] Updating Resource ID from %q to %q", id, newID)
+		rawState["id"] = newID
+
+		return rawState, nil
+	}
+}
@@ -58,3 +58,68 @@ func TestContainerV0ToV1(t *testing.T) {
 		t.Logf("[DEBUG] Ok!")
 	}
 }
+
+func TestContainerV1ToV2(t *testing.T) {
+	clouds := []*environments.Environment{
+		environments.AzurePublic(),
+		environments.AzureChina(),
+		environments.AzureUSGovernment(),
+	}
+
+	for _, cloud := range clouds {
+		t.Logf("[DEBUG] Testing with Cloud %q", cloud.Name)
+
+		meta := &clients.Client{
+			Account: &clients.ResourceManagerAccount{
+				Environment: *cloud,
+			},
+		}
+
+		suffix, ok := meta.Account.Environment.Storage.DomainSuffix()
+		if !ok {
+			t.Fatalf("could not determine Storage domain suffix for environment %q", meta.Account.Environment.Name)
+		}
+
+		expectedId := fmt.Sprintf("https://some-account.blob.%s/some-name", *suffix)
+
+		testData := []struct {
+			id string
+		}{
+			{
+				// legacy ID
+				id: "some-name/some-resource-group/some-account",
+			},
+			{
+				// already in the current format
+				id: expectedId,
+			},
+		}
+
+		for _, v := range testData {
+			t.Logf("[DEBUG] Testing %q", v.id)
+
+			input := map[string]interface{}{
+				"id":                   v.id,
+				"name":                 "some-name",
+				"storage_account_name": "some-account",
+			}
+
+			expected := map[string]interface{}{
+				"id":                   expectedId,
+				"name":                 "some-name",
+				"storage_account_name": "some-account",
+			}
+
+			actual, err := ContainerV1ToV2{}.UpgradeFunc()(context.TODO(), input, meta)
+			if err != nil {
+				t.Fatalf("Expected no error but got: %s", err)
+			}
+
+			if !reflect.DeepEqual(expected, actual) {
+				t.Fatalf("Expected %+v. Got %+v. But expected them to be the same", expected, actual)
+			}
+		}
+
+		t.Logf("[DEBUG] Ok!")
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// dnsZonePattern matches the DNS Zone segment within the host of a Storage Account using an Azure DNS Zone endpoint
+var dnsZonePattern = regexp.MustCompile(`^z[0-9]{2}$`)
+
+// DataPlaneHostDetails are the segments within the host of a Storage Data Plane URI
+type DataPlaneHostDetails struct {
+	AccountName  string
+	DnsZone      string
+	DomainSuffix string
+}
+
+// parseDataPlaneHost parses the host of a Storage Data Plane URI, which is either in the format
+// `{accountName}.{endpointType}.{domainSuffix}` or - for Storage Accounts using an Azure DNS Zone endpoint - in the
+// format `{accountName}.{dnsZone}.{endpointType}.{domainSuffix}` (e.g. `account1.z12.blob.storage.azure.net`)
+func parseDataPlaneHost(host, endpointType string) (*DataPlaneHostDetails, error) {
+	segments := strings.Split(host, ".")
+
+	result := DataPlaneHostDetails{}
+	if len(segments) > 0 {
+		result.AccountName = segments[0]
+		segments = segments[1:]
+	}
+	if len(segments) > 0 && dnsZonePattern.MatchString(segments[0]) {
+		result.DnsZone = segments[0]
+		segments = segments[1:]
+	}
+	if result.AccountName == "" || len(segments) < 2 || segments[0] != endpointType {
+		return nil, fmt.Errorf("expected the host to be in the format `{accountName}.%[1]s.{domainSuffix}` or `{accountName}.{dnsZone}.%[1]s.{domainSuffix}` but got %q", endpointType, host)
+	}
+	result.DomainSuffix = strings.Join(segments[1:], ".")
+
+	return &result, nil
+}
+
+// DataPlaneHost parses the host of a Data Plane endpoint reported for a Storage Account (e.g.
+// `https://account1.z12.blob.storage.azure.net/`), so that the ID of a resource within it can be built using the
+// same DNS Zone and Domain Suffix as the ID which is parsed when the resource is imported
+func DataPlaneHost(endpoint, endpointType string) (*DataPlaneHostDetails, error) {
+	uri, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", endpoint, err)
+	}
+
+	return parseDataPlaneHost(uri.Host, endpointType)
+}
+
+// formatDataPlaneHost returns the host of a Storage Data Plane URI, including the DNS Zone when one is specified
+func formatDataPlaneHost(accountName, dnsZone, endpointType, domainSuffix string) string {
+	if dnsZone != "" {
+		return fmt.Sprintf("%s.%s.%s.%s", accountName, dnsZone, endpointType, domainSuffix)
+	}
+
+	return fmt.Sprintf("%s.%s.%s", accountName, endpointType, domainSuffix)
+}
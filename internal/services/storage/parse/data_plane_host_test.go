@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"testing"
+)
+
+func TestParseDataPlaneHost(t *testing.T) {
+	testData := []struct {
+		Input        string
+		EndpointType string
+		Error        bool
+		Expected     *DataPlaneHostDetails
+	}{
+		{
+			// empty
+			Input:        "",
+			EndpointType: "blob",
+			Error:        true,
+		},
+
+		{
+			// missing domain suffix
+			Input:        "account1.blob",
+			EndpointType: "blob",
+			Error:        true,
+		},
+
+		{
+			// wrong endpoint type
+			Input:        "account1.table.core.windows.net",
+			EndpointType: "blob",
+			Error:        true,
+		},
+
+		{
+			// public cloud
+			Input:        "account1.blob.core.windows.net",
+			EndpointType: "blob",
+			Expected: &DataPlaneHostDetails{
+				AccountName:  "account1",
+				DomainSuffix: "core.windows.net",
+			},
+		},
+
+		{
+			// china cloud
+			Input:        "account1.table.core.chinacloudapi.cn",
+			EndpointType: "table",
+			Expected: &DataPlaneHostDetails{
+				AccountName:  "account1",
+				DomainSuffix: "core.chinacloudapi.cn",
+			},
+		},
+
+		{
+			// azure dns zone endpoint
+			Input:        "account1.z12.blob.storage.azure.net",
+			EndpointType: "blob",
+			Expected: &DataPlaneHostDetails{
+				AccountName:  "account1",
+				DnsZone:      "z12",
+				DomainSuffix: "storage.azure.net",
+			},
+		},
+
+		{
+			// azure dns zone endpoint for the wrong endpoint type
+			Input:        "account1.z12.queue.storage.azure.net",
+			EndpointType: "blob",
+			Error:        true,
+		},
+
+		{
+			// azure dns zone endpoint missing the endpoint type
+			Input:        "account1.z12.storage.azure.net",
+			EndpointType: "blob",
+			Error:        true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := parseDataPlaneHost(v.Input, v.EndpointType)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if *actual != *v.Expected {
+			t.Fatalf("Expected %+v but got %+v", *v.Expected, *actual)
+		}
+
+		// the host should round-trip, so that the ID of a Storage Account using a DNS Zone endpoint is retained
+		if host := formatDataPlaneHost(actual.AccountName, actual.DnsZone, v.EndpointType, actual.DomainSuffix); host != v.Input {
+			t.Fatalf("Expected the host to be formatted as %q but got %q", v.Input, host)
+		}
+	}
+}
+
+func TestDataPlaneHost(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *DataPlaneHostDetails
+	}{
+		{
+			// no host
+			Input: "/container1",
+			Error: true,
+		},
+
+		{
+			// public cloud
+			Input: "https://account1.blob.core.windows.net/",
+			Expected: &DataPlaneHostDetails{
+				AccountName:  "account1",
+				DomainSuffix: "core.windows.net",
+			},
+		},
+
+		{
+			// azure dns zone endpoint
+			Input: "https://account1.z12.blob.storage.azure.net/",
+			Expected: &DataPlaneHostDetails{
+				AccountName:  "account1",
+				DnsZone:      "z12",
+				DomainSuffix: "storage.azure.net",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := DataPlaneHost(v.Input, "blob")
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if *actual != *v.Expected {
+			t.Fatalf("Expected %+v but got %+v", *v.Expected, *actual)
+		}
+	}
+}
+
+func TestDataPlaneHostMatchesImportedID(t *testing.T) {
+	// the ID built for a Container when it's created must match the ID parsed when the same Container is imported,
+	// including for a Storage Account using an Azure DNS Zone endpoint
+	host, err := DataPlaneHost("https://account1.z12.blob.storage.azure.net/", "blob")
+	if err != nil {
+		t.Fatalf("parsing the host: %+v", err)
+	}
+
+	id := NewStorageContainerDataPlaneId(host.AccountName, host.DomainSuffix, "container1")
+	id.DnsZone = host.DnsZone
+
+	expected := "https://account1.z12.blob.storage.azure.net/container1"
+	if actual := id.ID(); actual != expected {
+		t.Fatalf("Expected the ID to be %q but got %q", expected, actual)
+	}
+
+	imported, err := StorageContainerDataPlaneID(expected)
+	if err != nil {
+		t.Fatalf("parsing %q: %+v", expected, err)
+	}
+	if *imported != id {
+		t.Fatalf("Expected the imported ID %+v to match the created ID %+v", *imported, id)
+	}
+}
@@ -17,6 +17,7 @@ var _ resourceids.Id = StorageContainerDataPlaneId{}
 type StorageContainerDataPlaneId struct {
 	AccountName  string
 	DomainSuffix string
+	DnsZone      string
 	Name         string
 }
 
@@ -26,11 +27,14 @@ func (id StorageContainerDataPlaneId) String() string {
 		fmt.Sprintf("Domain Suffix %q", id.DomainSuffix),
 		fmt.Sprintf("Name %q", id.Name),
 	}
+	if id.DnsZone != "" {
+		components = append(components, fmt.Sprintf("DNS Zone %q", id.DnsZone))
+	}
 	return fmt.Sprintf("Storage Container %s", strings.Join(components, " / "))
 }
 
 func (id StorageContainerDataPlaneId) ID() string {
-	return fmt.Sprintf("https://%s.blob.%s/%s", id.AccountName, id.DomainSuffix, id.Name)
+	return fmt.Sprintf("https://%s/%s", formatDataPlaneHost(id.AccountName, id.DnsZone, "blob", id.DomainSuffix), id.Name)
 }
 
 func NewStorageContainerDataPlaneId(accountName, domainSuffix, name string) StorageContainerDataPlaneId {
@@ -52,16 +56,15 @@ func StorageContainerDataPlaneID(id string) (*StorageContainerDataPlaneId, error
 		return nil, err
 	}
 
-	host := uri.Host
-	hostSegments := strings.Split(host, ".")
-	if len(hostSegments) == 0 {
-		return nil, fmt.Errorf("expected multiple host segments but got 0")
+	host, err := parseDataPlaneHost(uri.Host, "blob")
+	if err != nil {
+		return nil, err
 	}
-	domainNameSuffix := strings.TrimPrefix(host, fmt.Sprintf("%s.blob.", hostSegments[0]))
 
 	return &StorageContainerDataPlaneId{
 		AccountName:  parsed.AccountName,
-		DomainSuffix: domainNameSuffix,
+		DomainSuffix: host.DomainSuffix,
+		DnsZone:      host.DnsZone,
 		Name:         parsed.ContainerName,
 	}, nil
 }
@@ -17,6 +17,7 @@ var _ resourceids.Id = StorageQueueDataPlaneId{}
 type StorageQueueDataPlaneId struct {
 	AccountName  string
 	DomainSuffix string
+	DnsZone      string
 	Name         string
 }
 
@@ -26,11 +27,14 @@ func (id StorageQueueDataPlaneId) String() string {
 		fmt.Sprintf("Domain Suffix %q", id.DomainSuffix),
 		fmt.Sprintf("Name %q", id.Name),
 	}
+	if id.DnsZone != "" {
+		components = append(components, fmt.Sprintf("DNS Zone %q", id.DnsZone))
+	}
 	return fmt.Sprintf("Storage Queue %s", strings.Join(components, " / "))
 }
 
 func (id StorageQueueDataPlaneId) ID() string {
-	return fmt.Sprintf("https://%s.queue.%s/%s", id.AccountName, id.DomainSuffix, id.Name)
+	return fmt.Sprintf("https://%s/%s", formatDataPlaneHost(id.AccountName, id.DnsZone, "queue", id.DomainSuffix), id.Name)
 }
 
 func NewStorageQueueDataPlaneId(accountName, domainSuffix, name string) StorageQueueDataPlaneId {
@@ -52,16 +56,15 @@ func StorageQueueDataPlaneID(id string) (*StorageQueueDataPlaneId, error) {
 		return nil, err
 	}
 
-	host := uri.Host
-	hostSegments := strings.Split(host, ".")
-	if len(hostSegments) == 0 {
-		return nil, fmt.Errorf("expected multiple host segments but got 0")
+	host, err := parseDataPlaneHost(uri.Host, "queue")
+	if err != nil {
+		return nil, err
 	}
-	domainNameSuffix := strings.TrimPrefix(host, fmt.Sprintf("%s.queue.", hostSegments[0]))
 
 	return &StorageQueueDataPlaneId{
 		AccountName:  parsed.AccountName,
-		DomainSuffix: domainNameSuffix,
+		DomainSuffix: host.DomainSuffix,
+		DnsZone:      host.DnsZone,
 		Name:         parsed.QueueName,
 	}, nil
 }
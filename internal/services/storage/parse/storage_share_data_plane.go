@@ -18,6 +18,7 @@ var _ resourceids.Id = StorageShareDataPlaneId{}
 type StorageShareDataPlaneId struct {
 	AccountName  string
 	DomainSuffix string
+	DnsZone      string
 	Name         string
 }
 
@@ -27,11 +28,14 @@ func (id StorageShareDataPlaneId) String() string {
 		fmt.Sprintf("Domain Suffix %q", id.DomainSuffix),
 		fmt.Sprintf("Name %q", id.Name),
 	}
+	if id.DnsZone != "" {
+		components = append(components, fmt.Sprintf("DNS Zone %q", id.DnsZone))
+	}
 	return fmt.Sprintf("Storage Share (%s)", strings.Join(components, " / "))
 }
 
 func (id StorageShareDataPlaneId) ID() string {
-	return fmt.Sprintf("https://%s.file.%s/%s", id.AccountName, id.DomainSuffix, id.Name)
+	return fmt.Sprintf("https://%s/%s", formatDataPlaneHost(id.AccountName, id.DnsZone, "file", id.DomainSuffix), id.Name)
 }
 
 func NewStorageShareDataPlaneId(accountName, domainSuffix, name string) StorageShareDataPlaneId {
@@ -53,16 +57,15 @@ func StorageShareDataPlaneID(id string) (*StorageShareDataPlaneId, error) {
 		return nil, err
 	}
 
-	host := uri.Host
-	hostSegments := strings.Split(host, ".")
-	if len(hostSegments) == 0 {
-		return nil, fmt.Errorf("expected multiple host segments but got 0")
+	host, err := parseDataPlaneHost(uri.Host, "file")
+	if err != nil {
+		return nil, err
 	}
-	domainNameSuffix := strings.TrimPrefix(host, fmt.Sprintf("%s.file.", hostSegments[0]))
 
 	return &StorageShareDataPlaneId{
 		AccountName:  parsed.AccountName,
-		DomainSuffix: domainNameSuffix,
+		DomainSuffix: host.DomainSuffix,
+		DnsZone:      host.DnsZone,
 		Name:         parsed.ShareName,
 	}, nil
 }
@@ -18,6 +18,7 @@ var _ resourceids.Id = StorageTableDataPlaneId{}
 type StorageTableDataPlaneId struct {
 	AccountName  string
 	DomainSuffix string
+	DnsZone      string
 	Name         string
 }
 
@@ -27,11 +28,14 @@ func (id StorageTableDataPlaneId) String() string {
 		fmt.Sprintf("Domain Suffix %q", id.DomainSuffix),
 		fmt.Sprintf("Name %q", id.Name),
 	}
+	if id.DnsZone != "" {
+		components = append(components, fmt.Sprintf("DNS Zone %q", id.DnsZone))
+	}
 	return fmt.Sprintf("Storage Table %s", strings.Join(components, " / "))
 }
 
 func (id StorageTableDataPlaneId) ID() string {
-	return fmt.Sprintf("https://%s.table.%s/Tables('%s')", id.AccountName, id.DomainSuffix, id.Name)
+	return fmt.Sprintf("https://%s/Tables('%s')", formatDataPlaneHost(id.AccountName, id.DnsZone, "table", id.DomainSuffix), id.Name)
 }
 
 func NewStorageTableDataPlaneId(accountName, domainSuffix, name string) StorageTableDataPlaneId {
@@ -53,16 +57,15 @@ func StorageTableDataPlaneID(input string) (*StorageTableDataPlaneId, error) {
 		return nil, err
 	}
 
-	host := uri.Host
-	hostSegments := strings.Split(host, ".")
-	if len(hostSegments) == 0 {
-		return nil, fmt.Errorf("expected multiple host segments but got 0")
+	host, err := parseDataPlaneHost(uri.Host, "table")
+	if err != nil {
+		return nil, err
 	}
-	domainNameSuffix := strings.TrimPrefix(host, fmt.Sprintf("%s.table.", hostSegments[0]))
 
 	return &StorageTableDataPlaneId{
 		AccountName:  parsed.AccountName,
-		DomainSuffix: domainNameSuffix,
+		DomainSuffix: host.DomainSuffix,
+		DnsZone:      host.DnsZone,
 		Name:         parsed.TableName,
 	}, nil
 }
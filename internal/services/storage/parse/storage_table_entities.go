@@ -7,18 +7,20 @@ import (
 	"crypto/sha1"
 	"encoding/hex"
 	"fmt"
+	"net/url"
+	"regexp"
 	"strings"
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
-// TODO: tests for this
 var _ resourceids.Id = StorageTableEntitiesId{}
 
 type StorageTableEntitiesId struct {
 	AccountName  string
 	DomainSuffix string
+	DnsZone      string
 	TableName    string
 	Filter       string
 }
@@ -30,11 +32,14 @@ func (id StorageTableEntitiesId) String() string {
 		fmt.Sprintf("TableName %q", id.TableName),
 		fmt.Sprintf("Filter %q", id.Filter),
 	}
+	if id.DnsZone != "" {
+		components = append(components, fmt.Sprintf("DNS Zone %q", id.DnsZone))
+	}
 	return fmt.Sprintf("Storage Table %s", strings.Join(components, " / "))
 }
 
 func (id StorageTableEntitiesId) ID() string {
-	return fmt.Sprintf("https://%s.table.%s/%s(%s)", id.AccountName, id.DomainSuffix, id.TableName, id.Filter)
+	return fmt.Sprintf("https://%s/%s(%s)", formatDataPlaneHost(id.AccountName, id.DnsZone, "table", id.DomainSuffix), id.TableName, id.Filter)
 }
 
 func NewStorageTableEntitiesId(accountName, domainSuffix, tablename, filter string) StorageTableEntitiesId {
@@ -48,3 +53,29 @@ func NewStorageTableEntitiesId(accountName, domainSuffix, tablename, filter stri
 		Filter:       filterHash,
 	}
 }
+
+// StorageTableEntitiesID parses a Storage Table Entities ID, where the Filter is the hash of the OData filter
+func StorageTableEntitiesID(input string) (*StorageTableEntitiesId, error) {
+	uri, err := url.Parse(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as a URI: %+v", input, err)
+	}
+
+	host, err := parseDataPlaneHost(uri.Host, "table")
+	if err != nil {
+		return nil, err
+	}
+
+	matches := regexp.MustCompile(`^/([^/()]+)\(([0-9a-f]{40})\)$`).FindStringSubmatch(uri.Path)
+	if len(matches) != 3 {
+		return nil, fmt.Errorf("expected the path to be in the format `/{tableName}({filterHash})` but got %q", uri.Path)
+	}
+
+	return &StorageTableEntitiesId{
+		AccountName:  host.AccountName,
+		DomainSuffix: host.DomainSuffix,
+		DnsZone:      host.DnsZone,
+		TableName:    matches[1],
+		Filter:       matches[2],
+	}, nil
+}
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"testing"
+)
+
+func TestStorageTableEntitiesIDFormatter(t *testing.T) {
+	actual := NewStorageTableEntitiesId("account1", "core.windows.net", "table1", "PartitionKey eq 'partition1'").ID()
+	expected := "https://account1.table.core.windows.net/table1(2434763d304a186710273e70728af1c31440d579)"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestStorageTableEntitiesID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *StorageTableEntitiesId
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing table name and filter
+			Input: "https://account1.table.core.windows.net/",
+			Error: true,
+		},
+
+		{
+			// filter isn't a hash
+			Input: "https://account1.table.core.windows.net/table1(PartitionKey='partition1')",
+			Error: true,
+		},
+
+		{
+			// wrong service
+			Input: "https://account1.blob.core.windows.net/table1(2434763d304a186710273e70728af1c31440d579)",
+			Error: true,
+		},
+
+		{
+			// public cloud
+			Input: "https://account1.table.core.windows.net/table1(2434763d304a186710273e70728af1c31440d579)",
+			Expected: &StorageTableEntitiesId{
+				AccountName:  "account1",
+				DomainSuffix: "core.windows.net",
+				TableName:    "table1",
+				Filter:       "2434763d304a186710273e70728af1c31440d579",
+			},
+		},
+
+		{
+			// china cloud
+			Input: "https://account1.table.core.chinacloudapi.cn/table1(2434763d304a186710273e70728af1c31440d579)",
+			Expected: &StorageTableEntitiesId{
+				AccountName:  "account1",
+				DomainSuffix: "core.chinacloudapi.cn",
+				TableName:    "table1",
+				Filter:       "2434763d304a186710273e70728af1c31440d579",
+			},
+		},
+
+		{
+			// azure dns zone endpoint
+			Input: "https://account1.z12.table.storage.azure.net/table1(2434763d304a186710273e70728af1c31440d579)",
+			Expected: &StorageTableEntitiesId{
+				AccountName:  "account1",
+				DomainSuffix: "storage.azure.net",
+				DnsZone:      "z12",
+				TableName:    "table1",
+				Filter:       "2434763d304a186710273e70728af1c31440d579",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := StorageTableEntitiesID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if *actual != *v.Expected {
+			t.Fatalf("Expected %+v but got %+v", *v.Expected, *actual)
+		}
+	}
+}
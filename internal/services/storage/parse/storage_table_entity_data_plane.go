@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/table/entities"
+)
+
+var _ resourceids.Id = StorageTableEntityDataPlaneId{}
+
+type StorageTableEntityDataPlaneId struct {
+	AccountName  string
+	DomainSuffix string
+	DnsZone      string
+	TableName    string
+	PartitionKey string
+	RowKey       string
+}
+
+func (id StorageTableEntityDataPlaneId) String() string {
+	components := []string{
+		fmt.Sprintf("Account Name %q", id.AccountName),
+		fmt.Sprintf("Domain Suffix %q", id.DomainSuffix),
+		fmt.Sprintf("Table Name %q", id.TableName),
+		fmt.Sprintf("Partition Key %q", id.PartitionKey),
+		fmt.Sprintf("Row Key %q", id.RowKey),
+	}
+	if id.DnsZone != "" {
+		components = append(components, fmt.Sprintf("DNS Zone %q", id.DnsZone))
+	}
+	return fmt.Sprintf("Storage Table Entity %s", strings.Join(components, " / "))
+}
+
+func (id StorageTableEntityDataPlaneId) ID() string {
+	return fmt.Sprintf("https://%s/%s(PartitionKey='%s',RowKey='%s')", formatDataPlaneHost(id.AccountName, id.DnsZone, "table", id.DomainSuffix), id.TableName, id.PartitionKey, id.RowKey)
+}
+
+func NewStorageTableEntityDataPlaneId(accountName, domainSuffix, tableName, partitionKey, rowKey string) StorageTableEntityDataPlaneId {
+	return StorageTableEntityDataPlaneId{
+		AccountName:  accountName,
+		DomainSuffix: domainSuffix,
+		TableName:    tableName,
+		PartitionKey: partitionKey,
+		RowKey:       rowKey,
+	}
+}
+
+// StorageTableEntityDataPlaneID parses a Storage Table Entity Data Plane ID, the Domain Suffix is derived from
+// the host within the ID rather than the configured environment, allowing IDs from any cloud to be parsed
+func StorageTableEntityDataPlaneID(input string) (*StorageTableEntityDataPlaneId, error) {
+	parsed, err := entities.ParseResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	uri, err := url.Parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	host, err := parseDataPlaneHost(uri.Host, "table")
+	if err != nil {
+		return nil, err
+	}
+
+	return &StorageTableEntityDataPlaneId{
+		AccountName:  parsed.AccountName,
+		DomainSuffix: host.DomainSuffix,
+		DnsZone:      host.DnsZone,
+		TableName:    parsed.TableName,
+		PartitionKey: parsed.PartitionKey,
+		RowKey:       parsed.RowKey,
+	}, nil
+}
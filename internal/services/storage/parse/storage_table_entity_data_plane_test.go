@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"testing"
+)
+
+func TestStorageTableEntityDataPlaneIDFormatter(t *testing.T) {
+	actual := NewStorageTableEntityDataPlaneId("account1", "core.chinacloudapi.cn", "table1", "partition1", "row1").ID()
+	expected := "https://account1.table.core.chinacloudapi.cn/table1(PartitionKey='partition1',RowKey='row1')"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestStorageTableEntityDataPlaneIDFormatterDnsZone(t *testing.T) {
+	id := NewStorageTableEntityDataPlaneId("account1", "storage.azure.net", "table1", "partition1", "row1")
+	id.DnsZone = "z12"
+	actual := id.ID()
+	expected := "https://account1.z12.table.storage.azure.net/table1(PartitionKey='partition1',RowKey='row1')"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestStorageTableEntityDataPlaneID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *StorageTableEntityDataPlaneId
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing table name and keys
+			Input: "https://account1.table.core.windows.net/",
+			Error: true,
+		},
+
+		{
+			// missing row key
+			Input: "https://account1.table.core.windows.net/table1(PartitionKey='partition1')",
+			Error: true,
+		},
+
+		{
+			// wrong service
+			Input: "https://account1.blob.core.windows.net/table1(PartitionKey='partition1',RowKey='row1')",
+			Error: true,
+		},
+
+		{
+			// public cloud
+			Input: "https://account1.table.core.windows.net/table1(PartitionKey='partition1',RowKey='row1')",
+			Expected: &StorageTableEntityDataPlaneId{
+				AccountName:  "account1",
+				DomainSuffix: "core.windows.net",
+				TableName:    "table1",
+				PartitionKey: "partition1",
+				RowKey:       "row1",
+			},
+		},
+
+		{
+			// china cloud
+			Input: "https://account1.table.core.chinacloudapi.cn/table1(PartitionKey='partition1',RowKey='row1')",
+			Expected: &StorageTableEntityDataPlaneId{
+				AccountName:  "account1",
+				DomainSuffix: "core.chinacloudapi.cn",
+				TableName:    "table1",
+				PartitionKey: "partition1",
+				RowKey:       "row1",
+			},
+		},
+
+		{
+			// us government cloud, keys in the reverse order
+			Input: "https://account1.table.core.usgovcloudapi.net/table1(RowKey='row1',PartitionKey='partition1')",
+			Expected: &StorageTableEntityDataPlaneId{
+				AccountName:  "account1",
+				DomainSuffix: "core.usgovcloudapi.net",
+				TableName:    "table1",
+				PartitionKey: "partition1",
+				RowKey:       "row1",
+			},
+		},
+
+		{
+			// azure dns zone endpoint
+			Input: "https://account1.z12.table.storage.azure.net/table1(PartitionKey='partition1',RowKey='row1')",
+			Expected: &StorageTableEntityDataPlaneId{
+				AccountName:  "account1",
+				DomainSuffix: "storage.azure.net",
+				DnsZone:      "z12",
+				TableName:    "table1",
+				PartitionKey: "partition1",
+				RowKey:       "row1",
+			},
+		},
+
+		{
+			// azure dns zone endpoint for the wrong service
+			Input: "https://account1.z12.blob.storage.azure.net/table1(PartitionKey='partition1',RowKey='row1')",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := StorageTableEntityDataPlaneID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.AccountName != v.Expected.AccountName {
+			t.Fatalf("Expected %q but got %q for AccountName", v.Expected.AccountName, actual.AccountName)
+		}
+		if actual.DomainSuffix != v.Expected.DomainSuffix {
+			t.Fatalf("Expected %q but got %q for DomainSuffix", v.Expected.DomainSuffix, actual.DomainSuffix)
+		}
+		if actual.DnsZone != v.Expected.DnsZone {
+			t.Fatalf("Expected %q but got %q for DnsZone", v.Expected.DnsZone, actual.DnsZone)
+		}
+		if actual.TableName != v.Expected.TableName {
+			t.Fatalf("Expected %q but got %q for TableName", v.Expected.TableName, actual.TableName)
+		}
+		if actual.PartitionKey != v.Expected.PartitionKey {
+			t.Fatalf("Expected %q but got %q for PartitionKey", v.Expected.PartitionKey, actual.PartitionKey)
+		}
+		if actual.RowKey != v.Expected.RowKey {
+			t.Fatalf("Expected %q but got %q for RowKey", v.Expected.RowKey, actual.RowKey)
+		}
+	}
+}
@@ -39,6 +39,7 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 		"azurerm_storage_encryption_scope":           dataSourceStorageEncryptionScope(),
 		"azurerm_storage_management_policy":          dataSourceStorageManagementPolicy(),
 		"azurerm_storage_share":                      dataSourceStorageShare(),
+		"azurerm_storage_share_directory":            dataSourceStorageShareDirectory(),
 		"azurerm_storage_sync":                       dataSourceStorageSync(),
 		"azurerm_storage_sync_group":                 dataSourceStorageSyncGroup(),
 		"azurerm_storage_table_entity":               dataSourceStorageTableEntity(),
@@ -65,6 +66,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_storage_share_directory":              resourceStorageShareDirectory(),
 		"azurerm_storage_table":                        resourceStorageTable(),
 		"azurerm_storage_table_entity":                 resourceStorageTableEntity(),
+		"azurerm_storage_table_service_properties":     resourceStorageTableServiceProperties(),
 		"azurerm_storage_sync":                         resourceStorageSync(),
 		"azurerm_storage_sync_cloud_endpoint":          resourceStorageSyncCloudEndpoint(),
 		"azurerm_storage_sync_group":                   resourceStorageSyncGroup(),
@@ -75,11 +77,27 @@ func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
 		storageTableEntitiesDataSource{},
 		storageContainersDataSource{},
+		storageBlobsDataSource{},
+		storageAnalyticsLogsDataSource{},
+		storageAccountBlobChangeFeedDataSource{},
+		storageAccountGeoReplicationDataSource{},
+		storageAccountLocationDataSource{},
+		storageContainerUsageDataSource{},
+		storageContainerInventoryDataSource{},
+		storageTablesDataSource{},
+		storageBlobLeaseDataSource{},
 	}
 }
 
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		LocalUserResource{},
+		StorageAccountStaticWebsiteDataPlaneResource{},
+		StorageContainerImmutabilityPolicyResource{},
+		StorageBlobCopyResource{},
+		StorageBlobLegalHoldResource{},
+		StorageBlobServicePropertiesDataPlaneResource{},
+		StorageTableEntityCleanupResource{},
+		StorageTableEntityCopyResource{},
 	}
 }
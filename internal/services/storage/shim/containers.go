@@ -10,17 +10,51 @@ import (
 )
 
 type StorageContainerWrapper interface {
-	Create(ctx context.Context, resourceGroup, accountName, containerName string, input containers.CreateInput) error
+	Create(ctx context.Context, resourceGroup, accountName, containerName string, input StorageContainerCreateInput) error
 	Delete(ctx context.Context, resourceGroup, accountName, containerName string) error
 	Exists(ctx context.Context, resourceGroup, accountName, containerName string) (*bool, error)
 	Get(ctx context.Context, resourceGroup, accountName, containerName string) (*StorageContainerProperties, error)
+	GetAccessControl(ctx context.Context, resourceGroup, accountName, containerName string) (*[]StorageContainerSignedIdentifier, error)
+	ListBlobs(ctx context.Context, resourceGroup, accountName, containerName string, input containers.ListBlobsInput) (*[]containers.BlobDetails, error)
 	UpdateAccessLevel(ctx context.Context, resourceGroup, accountName, containerName string, level containers.AccessLevel) error
 	UpdateMetaData(ctx context.Context, resourceGroup, accountName, containerName string, metadata map[string]string) error
 }
 
+// StorageContainerCreateInput extends the Data Plane SDK's CreateInput with the Encryption Scope, which can only be
+// specified when the Container is created
+type StorageContainerCreateInput struct {
+	containers.CreateInput
+
+	// DefaultEncryptionScope is the Encryption Scope used for Blobs within this Container - when empty the default
+	// Encryption Scope for the Storage Account is used
+	DefaultEncryptionScope string
+
+	// EncryptionScopeOverrideEnabled specifies whether Blobs within this Container can use an Encryption Scope other
+	// than the DefaultEncryptionScope, this is only applicable when the DefaultEncryptionScope is specified
+	EncryptionScopeOverrideEnabled bool
+}
+
 type StorageContainerProperties struct {
-	AccessLevel           containers.AccessLevel
-	MetaData              map[string]string
-	HasImmutabilityPolicy bool
-	HasLegalHold          bool
+	AccessLevel                    containers.AccessLevel
+	MetaData                       map[string]string
+	DefaultEncryptionScope         string
+	EncryptionScopeOverrideEnabled bool
+	HasImmutabilityPolicy          bool
+	HasLegalHold                   bool
+	LeaseDuration                  *containers.LeaseDuration
+	LeaseState                     containers.LeaseState
+	LeaseStatus                    containers.LeaseStatus
+}
+
+// StorageContainerSignedIdentifier is a Stored Access Policy (ACL) assigned to a Container, since these aren't
+// available in the Data Plane SDK
+type StorageContainerSignedIdentifier struct {
+	Id           string                       `xml:"Id"`
+	AccessPolicy StorageContainerAccessPolicy `xml:"AccessPolicy"`
+}
+
+type StorageContainerAccessPolicy struct {
+	Start      string `xml:"Start"`
+	Expiry     string `xml:"Expiry"`
+	Permission string `xml:"Permission"`
 }
@@ -6,9 +6,13 @@ package shim
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/blob/containers"
@@ -24,19 +28,20 @@ func NewDataPlaneStorageContainerWrapper(client *containers.Client) StorageConta
 	}
 }
 
-func (w DataPlaneStorageContainerWrapper) Create(ctx context.Context, _, accountName, containerName string, input containers.CreateInput) error {
+func (w DataPlaneStorageContainerWrapper) Create(ctx context.Context, _, accountName, containerName string, input StorageContainerCreateInput) error {
 	timeout, ok := ctx.Deadline()
 	if !ok {
 		return fmt.Errorf("context is missing a timeout")
 	}
 
-	if resp, err := w.client.Create(ctx, accountName, containerName, input); err != nil {
+	client := w.clientWithEncryptionScope(input)
+	if resp, err := client.Create(ctx, accountName, containerName, input.CreateInput); err != nil {
 		// If we fail due to previous delete still in progress, then we can retry
 		if utils.ResponseWasConflict(resp.Response) && strings.Contains(err.Error(), "ContainerBeingDeleted") {
 			stateConf := &pluginsdk.StateChangeConf{
 				Pending:        []string{"waitingOnDelete"},
 				Target:         []string{"succeeded"},
-				Refresh:        w.createRefreshFunc(ctx, accountName, containerName, input),
+				Refresh:        createRefreshFunc(ctx, client, accountName, containerName, input.CreateInput),
 				PollInterval:   10 * time.Second,
 				NotFoundChecks: 180,
 				Timeout:        time.Until(timeout),
@@ -83,12 +88,88 @@ func (w DataPlaneStorageContainerWrapper) Get(ctx context.Context, _, accountNam
 		return nil, err
 	}
 
-	return &StorageContainerProperties{
+	result := StorageContainerProperties{
 		AccessLevel:           props.AccessLevel,
 		MetaData:              props.MetaData,
 		HasImmutabilityPolicy: props.HasImmutabilityPolicy,
 		HasLegalHold:          props.HasLegalHold,
-	}, nil
+		LeaseDuration:         props.LeaseDuration,
+		LeaseState:            props.LeaseState,
+		LeaseStatus:           props.LeaseStatus,
+	}
+
+	// the Encryption Scope isn't parsed by the SDK, so is pulled from the response headers - the override is
+	// permitted unless the Container explicitly denies this
+	if resp := props.Response.Response; resp != nil {
+		result.DefaultEncryptionScope = resp.Header.Get("x-ms-default-encryption-scope")
+		result.EncryptionScopeOverrideEnabled = !strings.EqualFold(resp.Header.Get("x-ms-deny-encryption-scope-override"), "true")
+	}
+
+	return &result, nil
+}
+
+// GetAccessControl returns the Stored Access Policies (ACL's) for the specified Container, which is done using the
+// Containers Client directly since this operation isn't available in the Data Plane SDK
+func (w DataPlaneStorageContainerWrapper) GetAccessControl(ctx context.Context, _, accountName, containerName string) (*[]StorageContainerSignedIdentifier, error) {
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(fmt.Sprintf("https://%s.blob.%s", accountName, w.client.BaseURI)),
+		autorest.WithPathParameters("/{containerName}", map[string]interface{}{
+			"containerName": autorest.Encode("path", containerName),
+		}),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"restype": autorest.Encode("query", "container"),
+			"comp":    autorest.Encode("query", "acl"),
+		}),
+		autorest.WithHeaders(map[string]interface{}{
+			"x-ms-version": containers.APIVersion,
+		}))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, "shim.DataPlaneStorageContainerWrapper", "GetAccessControl", nil, "Failure preparing request")
+	}
+
+	resp, err := autorest.SendWithSender(w.client, req, azure.DoRetryWithRegistration(w.client.Client))
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, "shim.DataPlaneStorageContainerWrapper", "GetAccessControl", resp, "Failure sending request")
+	}
+
+	var result struct {
+		SignedIdentifiers []StorageContainerSignedIdentifier `xml:"SignedIdentifier"`
+	}
+	err = autorest.Respond(
+		resp,
+		w.client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingXML(&result),
+		autorest.ByClosing())
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, "shim.DataPlaneStorageContainerWrapper", "GetAccessControl", resp, "Failure responding to request")
+	}
+
+	return &result.SignedIdentifiers, nil
+}
+
+// ListBlobs returns all of the Blobs matching the specified input, paging through the results until
+// there are no further continuation tokens (`NextMarker`)
+func (w DataPlaneStorageContainerWrapper) ListBlobs(ctx context.Context, _, accountName, containerName string, input containers.ListBlobsInput) (*[]containers.BlobDetails, error) {
+	results := make([]containers.BlobDetails, 0)
+
+	for {
+		resp, err := w.client.ListBlobs(ctx, accountName, containerName, input)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, resp.Blobs.Blobs...)
+
+		if resp.NextMarker == nil || *resp.NextMarker == "" {
+			break
+		}
+		input.Marker = resp.NextMarker
+	}
+
+	return &results, nil
 }
 
 func (w DataPlaneStorageContainerWrapper) UpdateAccessLevel(ctx context.Context, _, accountName, containerName string, level containers.AccessLevel) error {
@@ -101,9 +182,42 @@ func (w DataPlaneStorageContainerWrapper) UpdateMetaData(ctx context.Context, _,
 	return err
 }
 
-func (w DataPlaneStorageContainerWrapper) createRefreshFunc(ctx context.Context, accountName string, containerName string, input containers.CreateInput) pluginsdk.StateRefreshFunc {
+// clientWithEncryptionScope returns a Containers Client which sets the Encryption Scope headers when creating a
+// Container, since these aren't supported by the SDK's CreateInput
+func (w DataPlaneStorageContainerWrapper) clientWithEncryptionScope(input StorageContainerCreateInput) *containers.Client {
+	if input.DefaultEncryptionScope == "" {
+		return w.client
+	}
+
+	client := *w.client
+	inspector := client.RequestInspector
+	client.RequestInspector = func(p autorest.Preparer) autorest.Preparer {
+		if inspector != nil {
+			p = inspector(p)
+		}
+		return withEncryptionScopeHeaders(input)(p)
+	}
+	return &client
+}
+
+// withEncryptionScopeHeaders sets the Encryption Scope headers on the request. NOTE: the Request Inspector wraps the
+// Authorizer, as such these must be set before the inner Preparer runs - since they're part of the Shared Key signature.
+func withEncryptionScopeHeaders(input StorageContainerCreateInput) autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			if r.Header == nil {
+				r.Header = http.Header{}
+			}
+			r.Header.Set("x-ms-default-encryption-scope", input.DefaultEncryptionScope)
+			r.Header.Set("x-ms-deny-encryption-scope-override", strconv.FormatBool(!input.EncryptionScopeOverrideEnabled))
+			return p.Prepare(r)
+		})
+	}
+}
+
+func createRefreshFunc(ctx context.Context, client *containers.Client, accountName string, containerName string, input containers.CreateInput) pluginsdk.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		resp, err := w.client.Create(ctx, accountName, containerName, input)
+		resp, err := client.Create(ctx, accountName, containerName, input)
 		if err != nil {
 			if !utils.ResponseWasConflict(resp.Response) {
 				return nil, "", err
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package shim
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/blob/containers"
+)
+
+func TestDataPlaneStorageContainerWrapperCreateSignsEncryptionScopeHeaders(t *testing.T) {
+	// the key must be valid base64, but is otherwise arbitrary
+	authorizer, err := autorest.NewSharedKeyAuthorizer("example", "c2VjcmV0", autorest.SharedKey)
+	if err != nil {
+		t.Fatalf("building Shared Key Authorizer: %+v", err)
+	}
+
+	var actual *http.Request
+	client := containers.New()
+	client.Authorizer = authorizer
+	client.RetryAttempts = 1
+	client.RetryDuration = 0
+	client.Sender = autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		actual = r
+		return &http.Response{
+			Request:    r,
+			StatusCode: http.StatusCreated,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader("")),
+		}, nil
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	input := StorageContainerCreateInput{
+		DefaultEncryptionScope:         "scope1",
+		EncryptionScopeOverrideEnabled: false,
+	}
+	if err := NewDataPlaneStorageContainerWrapper(&client).Create(ctx, "", "example", "container1", input); err != nil {
+		t.Fatalf("creating Container: %+v", err)
+	}
+
+	if v := actual.Header.Get("x-ms-default-encryption-scope"); v != "scope1" {
+		t.Fatalf("expected the header `x-ms-default-encryption-scope` to be %q but got %q", "scope1", v)
+	}
+	if v := actual.Header.Get("x-ms-deny-encryption-scope-override"); v != "true" {
+		t.Fatalf("expected the header `x-ms-deny-encryption-scope-override` to be %q but got %q", "true", v)
+	}
+
+	// signing the request which was sent again must give the same signature, which is only the case when both of
+	// the Encryption Scope headers were present when the request was originally signed
+	signature := actual.Header.Get("Authorization")
+	if signature == "" {
+		t.Fatalf("expected the request to be signed")
+	}
+	resigned := actual.Clone(context.Background())
+	resigned.Header.Del("Authorization")
+	resigned, err = autorest.Prepare(resigned, authorizer.WithAuthorization())
+	if err != nil {
+		t.Fatalf("signing the request: %+v", err)
+	}
+	if v := resigned.Header.Get("Authorization"); v != signature {
+		t.Fatalf("expected the signature to cover the Encryption Scope headers - expected %q but got %q", v, signature)
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package shim
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/blobcontainers"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/blob/containers"
+)
+
+// ResourceManagerStorageContainerWrapper manages Storage Containers using the Resource Manager API rather than
+// the Data Plane API, for use where direct access to the Data Plane isn't permitted
+type ResourceManagerStorageContainerWrapper struct {
+	client         *blobcontainers.BlobContainersClient
+	subscriptionId string
+}
+
+func NewResourceManagerStorageContainerWrapper(client *blobcontainers.BlobContainersClient, subscriptionId string) StorageContainerWrapper {
+	return ResourceManagerStorageContainerWrapper{
+		client:         client,
+		subscriptionId: subscriptionId,
+	}
+}
+
+func (w ResourceManagerStorageContainerWrapper) Create(ctx context.Context, resourceGroup, accountName, containerName string, input StorageContainerCreateInput) error {
+	id := commonids.NewStorageContainerID(w.subscriptionId, resourceGroup, accountName, containerName)
+	payload := blobcontainers.BlobContainer{
+		Properties: &blobcontainers.ContainerProperties{
+			PublicAccess: pointer.To(expandResourceManagerContainerAccessLevel(input.AccessLevel)),
+			Metadata:     pointer.To(input.MetaData),
+		},
+	}
+	if input.DefaultEncryptionScope != "" {
+		payload.Properties.DefaultEncryptionScope = pointer.To(input.DefaultEncryptionScope)
+		payload.Properties.DenyEncryptionScopeOverride = pointer.To(!input.EncryptionScopeOverrideEnabled)
+	}
+
+	if _, err := w.client.Create(ctx, id, payload); err != nil {
+		return fmt.Errorf("failed creating container: %+v", err)
+	}
+	return nil
+}
+
+func (w ResourceManagerStorageContainerWrapper) Delete(ctx context.Context, resourceGroup, accountName, containerName string) error {
+	id := commonids.NewStorageContainerID(w.subscriptionId, resourceGroup, accountName, containerName)
+	resp, err := w.client.Delete(ctx, id)
+	if response.WasNotFound(resp.HttpResponse) {
+		return nil
+	}
+
+	return err
+}
+
+func (w ResourceManagerStorageContainerWrapper) Exists(ctx context.Context, resourceGroup, accountName, containerName string) (*bool, error) {
+	id := commonids.NewStorageContainerID(w.subscriptionId, resourceGroup, accountName, containerName)
+	existing, err := w.client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return nil, err
+		}
+	}
+
+	exists := !response.WasNotFound(existing.HttpResponse)
+	return &exists, nil
+}
+
+func (w ResourceManagerStorageContainerWrapper) Get(ctx context.Context, resourceGroup, accountName, containerName string) (*StorageContainerProperties, error) {
+	id := commonids.NewStorageContainerID(w.subscriptionId, resourceGroup, accountName, containerName)
+	existing, err := w.client.Get(ctx, id)
+	if err != nil {
+		if response.WasNotFound(existing.HttpResponse) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	result := StorageContainerProperties{
+		AccessLevel:                    containers.Private,
+		MetaData:                       map[string]string{},
+		EncryptionScopeOverrideEnabled: true,
+	}
+	if model := existing.Model; model != nil && model.Properties != nil {
+		props := model.Properties
+		result.AccessLevel = flattenResourceManagerContainerAccessLevel(props.PublicAccess)
+		if props.Metadata != nil {
+			result.MetaData = *props.Metadata
+		}
+		result.DefaultEncryptionScope = pointer.From(props.DefaultEncryptionScope)
+		result.EncryptionScopeOverrideEnabled = !pointer.From(props.DenyEncryptionScopeOverride)
+		result.HasImmutabilityPolicy = pointer.From(props.HasImmutabilityPolicy)
+		result.HasLegalHold = pointer.From(props.HasLegalHold)
+
+		// the Resource Manager API returns these values in PascalCase, rather than the lower-case used by the Data Plane
+		if props.LeaseDuration != nil {
+			result.LeaseDuration = pointer.To(containers.LeaseDuration(strings.ToLower(string(*props.LeaseDuration))))
+		}
+		result.LeaseState = containers.LeaseState(strings.ToLower(string(pointer.From(props.LeaseState))))
+		result.LeaseStatus = containers.LeaseStatus(strings.ToLower(string(pointer.From(props.LeaseStatus))))
+	}
+
+	return &result, nil
+}
+
+func (w ResourceManagerStorageContainerWrapper) GetAccessControl(_ context.Context, _, accountName, containerName string) (*[]StorageContainerSignedIdentifier, error) {
+	return nil, fmt.Errorf("retrieving the Stored Access Policies for Container %q (Storage Account %q) requires access to the Data Plane API, which isn't available when Storage Containers are managed using the Resource Manager API", containerName, accountName)
+}
+
+func (w ResourceManagerStorageContainerWrapper) ListBlobs(_ context.Context, _, accountName, containerName string, _ containers.ListBlobsInput) (*[]containers.BlobDetails, error) {
+	return nil, fmt.Errorf("listing the Blobs within Container %q (Storage Account %q) requires access to the Data Plane API, which isn't available when Storage Containers are managed using the Resource Manager API", containerName, accountName)
+}
+
+func (w ResourceManagerStorageContainerWrapper) UpdateAccessLevel(ctx context.Context, resourceGroup, accountName, containerName string, level containers.AccessLevel) error {
+	id := commonids.NewStorageContainerID(w.subscriptionId, resourceGroup, accountName, containerName)
+	payload := blobcontainers.BlobContainer{
+		Properties: &blobcontainers.ContainerProperties{
+			PublicAccess: pointer.To(expandResourceManagerContainerAccessLevel(level)),
+		},
+	}
+
+	_, err := w.client.Update(ctx, id, payload)
+	return err
+}
+
+func (w ResourceManagerStorageContainerWrapper) UpdateMetaData(ctx context.Context, resourceGroup, accountName, containerName string, metaData map[string]string) error {
+	id := commonids.NewStorageContainerID(w.subscriptionId, resourceGroup, accountName, containerName)
+	payload := blobcontainers.BlobContainer{
+		Properties: &blobcontainers.ContainerProperties{
+			Metadata: pointer.To(metaData),
+		},
+	}
+
+	_, err := w.client.Update(ctx, id, payload)
+	return err
+}
+
+func expandResourceManagerContainerAccessLevel(input containers.AccessLevel) blobcontainers.PublicAccess {
+	switch input {
+	case containers.Blob:
+		return blobcontainers.PublicAccessBlob
+	case containers.Container:
+		return blobcontainers.PublicAccessContainer
+	}
+
+	return blobcontainers.PublicAccessNone
+}
+
+func flattenResourceManagerContainerAccessLevel(input *blobcontainers.PublicAccess) containers.AccessLevel {
+	if input == nil {
+		return containers.Private
+	}
+
+	switch *input {
+	case blobcontainers.PublicAccessBlob:
+		return containers.Blob
+	case blobcontainers.PublicAccessContainer:
+		return containers.Container
+	}
+
+	return containers.Private
+}
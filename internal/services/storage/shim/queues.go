@@ -21,4 +21,8 @@ type StorageQueuesWrapper interface {
 
 type StorageQueueProperties struct {
 	MetaData map[string]string
+
+	// ApproximateMessageCount is the approximate number of messages within the Queue, which may be higher than the
+	// actual number of messages since this is only updated periodically by the Queue Service
+	ApproximateMessageCount int
 }
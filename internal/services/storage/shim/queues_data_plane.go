@@ -5,6 +5,8 @@ package shim
 
 import (
 	"context"
+	"fmt"
+	"strconv"
 
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/queue/queues"
@@ -51,8 +53,20 @@ func (w DataPlaneStorageQueueWrapper) Get(ctx context.Context, _, accountName, q
 		return nil, err
 	}
 
+	approximateMessageCount := 0
+	if props.Response.Response != nil {
+		if v := props.Response.Header.Get("x-ms-approximate-messages-count"); v != "" {
+			count, err := strconv.Atoi(v)
+			if err != nil {
+				return nil, fmt.Errorf("parsing the Approximate Messages Count %q: %+v", v, err)
+			}
+			approximateMessageCount = count
+		}
+	}
+
 	return &StorageQueueProperties{
-		MetaData: props.MetaData,
+		MetaData:                props.MetaData,
+		ApproximateMessageCount: approximateMessageCount,
 	}, nil
 }
 
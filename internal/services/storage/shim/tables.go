@@ -6,6 +6,7 @@ package shim
 import (
 	"context"
 
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/table/tables"
 )
 
@@ -13,6 +14,13 @@ type StorageTableWrapper interface {
 	Create(ctx context.Context, resourceGroup string, accountName string, tableName string) error
 	Delete(ctx context.Context, resourceGroup string, accountName string, tableName string) error
 	Exists(ctx context.Context, resourceGroup string, accountName string, tableName string) (*bool, error)
+	List(ctx context.Context, resourceGroup string, accountName string) (*[]string, error)
 	GetACLs(ctx context.Context, resourceGroup string, accountName string, tableName string) (*[]tables.SignedIdentifier, error)
 	UpdateACLs(ctx context.Context, resourceGroup string, accountName string, tableName string, acls []tables.SignedIdentifier) error
 }
+
+// StorageTableACLClient replaces the ACL's for a Storage Table, which is separate from the Tables Client so that the
+// ACL's can be sent in the form the Table Service expects (omitting an empty `Start` or `Expiry`)
+type StorageTableACLClient interface {
+	SetACL(ctx context.Context, accountName string, tableName string, acls []tables.SignedIdentifier) (autorest.Response, error)
+}
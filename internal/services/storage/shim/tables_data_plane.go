@@ -5,23 +5,57 @@ package shim
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/table/tables"
 )
 
 type DataPlaneStorageTableWrapper struct {
-	client *tables.Client
+	client    *tables.Client
+	aclClient StorageTableACLClient
 }
 
-func NewDataPlaneStorageTableWrapper(client *tables.Client) StorageTableWrapper {
+func NewDataPlaneStorageTableWrapper(client *tables.Client, aclClient StorageTableACLClient) StorageTableWrapper {
 	return DataPlaneStorageTableWrapper{
-		client: client,
+		client:    client,
+		aclClient: aclClient,
 	}
 }
 
 func (w DataPlaneStorageTableWrapper) Create(ctx context.Context, _, accountName, tableName string) error {
-	_, err := w.client.Create(ctx, accountName, tableName)
+	timeout, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context is missing a timeout")
+	}
+
+	resp, err := w.client.Create(ctx, accountName, tableName)
+	if err == nil {
+		return nil
+	}
+
+	// a Table is removed asynchronously, during which time creating a Table with the same name returns a 409
+	// - so if a previous delete is still in progress we wait for this to complete and then retry
+	if utils.ResponseWasConflict(resp) && strings.Contains(err.Error(), "TableBeingDeleted") {
+		stateConf := &pluginsdk.StateChangeConf{
+			Pending:        []string{"waitingOnDelete"},
+			Target:         []string{"succeeded"},
+			Refresh:        w.createRefreshFunc(ctx, accountName, tableName),
+			PollInterval:   10 * time.Second,
+			NotFoundChecks: 180,
+			Timeout:        time.Until(timeout),
+		}
+
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return fmt.Errorf("a Table with the same name was recently deleted and is still being removed by the Table Service, which can take several minutes - waiting for this to complete: %+v", err)
+		}
+		return nil
+	}
+
+	// otherwise it's a legit error, so raise it
 	return err
 }
 
@@ -43,6 +77,44 @@ func (w DataPlaneStorageTableWrapper) Exists(ctx context.Context, _, accountName
 	return utils.Bool(true), nil
 }
 
+func (w DataPlaneStorageTableWrapper) List(ctx context.Context, _, accountName string) (*[]string, error) {
+	names := make([]string, 0)
+
+	// the Query operation in the SDK doesn't support Continuation Tokens, so the request is updated to include
+	// the `NextTableName` returned from the previous page until all of the Tables have been retrieved
+	nextTableName := ""
+	for {
+		req, err := w.client.QueryPreparer(ctx, accountName, tables.NoMetaData)
+		if err != nil {
+			return nil, fmt.Errorf("preparing request: %+v", err)
+		}
+		if nextTableName != "" {
+			query := req.URL.Query()
+			query.Set("NextTableName", nextTableName)
+			req.URL.RawQuery = query.Encode()
+		}
+
+		resp, err := w.client.QuerySender(req)
+		if err != nil {
+			return nil, fmt.Errorf("sending request: %+v", err)
+		}
+
+		result, err := w.client.QueryResponder(resp)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving Tables: %+v", err)
+		}
+
+		for _, item := range result.Tables {
+			names = append(names, item.TableName)
+		}
+
+		nextTableName = resp.Header.Get("x-ms-continuation-NextTableName")
+		if nextTableName == "" {
+			return &names, nil
+		}
+	}
+}
+
 func (w DataPlaneStorageTableWrapper) GetACLs(ctx context.Context, _, accountName, tableName string) (*[]tables.SignedIdentifier, error) {
 	acls, err := w.client.GetACL(ctx, accountName, tableName)
 	if err != nil {
@@ -53,6 +125,21 @@ func (w DataPlaneStorageTableWrapper) GetACLs(ctx context.Context, _, accountNam
 }
 
 func (w DataPlaneStorageTableWrapper) UpdateACLs(ctx context.Context, _, accountName, tableName string, acls []tables.SignedIdentifier) error {
-	_, err := w.client.SetACL(ctx, accountName, tableName, acls)
+	_, err := w.aclClient.SetACL(ctx, accountName, tableName, acls)
 	return err
 }
+
+func (w DataPlaneStorageTableWrapper) createRefreshFunc(ctx context.Context, accountName string, tableName string) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := w.client.Create(ctx, accountName, tableName)
+		if err != nil {
+			if utils.ResponseWasConflict(resp) && strings.Contains(err.Error(), "TableBeingDeleted") {
+				return nil, "waitingOnDelete", nil
+			}
+
+			return nil, "", err
+		}
+
+		return "succeeded", "succeeded", nil
+	}
+}
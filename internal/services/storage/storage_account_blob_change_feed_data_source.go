@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type storageAccountBlobChangeFeedDataSource struct{}
+
+var _ sdk.DataSource = storageAccountBlobChangeFeedDataSource{}
+
+type storageAccountBlobChangeFeedDataSourceModel struct {
+	StorageAccountId string `tfschema:"storage_account_id"`
+	Enabled          bool   `tfschema:"enabled"`
+	RetentionInDays  int64  `tfschema:"retention_in_days"`
+}
+
+func (r storageAccountBlobChangeFeedDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: commonids.ValidateStorageAccountID,
+		},
+	}
+}
+
+func (r storageAccountBlobChangeFeedDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
+		"retention_in_days": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+	}
+}
+
+func (r storageAccountBlobChangeFeedDataSource) ResourceType() string {
+	return "azurerm_storage_account_blob_change_feed"
+}
+
+func (r storageAccountBlobChangeFeedDataSource) ModelObject() interface{} {
+	return &storageAccountBlobChangeFeedDataSourceModel{}
+}
+
+func (r storageAccountBlobChangeFeedDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// NOTE: the Change Feed isn't exposed by the Data Plane Blob Service Properties API, so this is retrieved
+			// from Resource Manager
+			client := metadata.Client.Storage.ResourceManager.BlobService
+
+			var plan storageAccountBlobChangeFeedDataSourceModel
+			if err := metadata.Decode(&plan); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			id, err := commonids.ParseStorageAccountID(plan.StorageAccountId)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.GetServiceProperties(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("retrieving Blob Service Properties for %s: %+v", id, err)
+			}
+
+			// the Change Feed is omitted entirely when it's never been enabled
+			plan.Enabled = false
+			plan.RetentionInDays = 0
+			if model := resp.Model; model != nil && model.Properties != nil && model.Properties.ChangeFeed != nil {
+				plan.Enabled = pointer.From(model.Properties.ChangeFeed.Enabled)
+				plan.RetentionInDays = pointer.From(model.Properties.ChangeFeed.RetentionInDays)
+			}
+
+			if err := metadata.Encode(&plan); err != nil {
+				return fmt.Errorf("encoding %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type storageAccountBlobChangeFeedDataSource struct{}
+
+func TestAccDataSourceStorageAccountBlobChangeFeed_disabled(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_account_blob_change_feed", "test")
+	d := storageAccountBlobChangeFeedDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: d.basic(data, false),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("enabled").HasValue("false"),
+				check.That(data.ResourceName).Key("retention_in_days").HasValue("0"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceStorageAccountBlobChangeFeed_enabled(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_account_blob_change_feed", "test")
+	d := storageAccountBlobChangeFeedDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: d.basic(data, true),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("retention_in_days").HasValue("7"),
+			),
+		},
+	})
+}
+
+func (d storageAccountBlobChangeFeedDataSource) basic(data acceptance.TestData, enabled bool) string {
+	retention := "null"
+	if enabled {
+		retention = "7"
+	}
+
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+
+  blob_properties {
+    change_feed_enabled           = %t
+    change_feed_retention_in_days = %s
+  }
+}
+
+data "azurerm_storage_account_blob_change_feed" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, enabled, retention)
+}
@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type storageAccountGeoReplicationDataSource struct{}
+
+var _ sdk.DataSource = storageAccountGeoReplicationDataSource{}
+
+type storageAccountGeoReplicationDataSourceModel struct {
+	StorageAccountId string `tfschema:"storage_account_id"`
+	Status           string `tfschema:"status"`
+	LastSyncTime     string `tfschema:"last_sync_time"`
+}
+
+func (r storageAccountGeoReplicationDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: commonids.ValidateStorageAccountID,
+		},
+	}
+}
+
+func (r storageAccountGeoReplicationDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"last_sync_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r storageAccountGeoReplicationDataSource) ResourceType() string {
+	return "azurerm_storage_account_geo_replication"
+}
+
+func (r storageAccountGeoReplicationDataSource) ModelObject() interface{} {
+	return &storageAccountGeoReplicationDataSourceModel{}
+}
+
+func (r storageAccountGeoReplicationDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			var plan storageAccountGeoReplicationDataSourceModel
+			if err := metadata.Decode(&plan); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			id, err := commonids.ParseStorageAccountID(plan.StorageAccountId)
+			if err != nil {
+				return err
+			}
+
+			account, err := storageClient.FindAccount(ctx, id.StorageAccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if account == nil {
+				return fmt.Errorf("%s was not found", id)
+			}
+
+			// the Geo-Replication statistics are only available from the secondary location, which can only be read
+			// when read-access geo-redundant replication is enabled - otherwise these are left empty
+			plan.Status = ""
+			plan.LastSyncTime = ""
+			if props := account.Properties; props != nil && props.SecondaryEndpoints != nil && props.SecondaryEndpoints.Blob != nil {
+				client, err := storageClient.BlobServiceStatsClient(ctx, *account)
+				if err != nil {
+					return fmt.Errorf("building Blob Service Stats Client for %s: %+v", id, err)
+				}
+
+				resp, err := client.GetServiceStats(ctx, id.StorageAccountName)
+				if err != nil {
+					return fmt.Errorf("retrieving the Blob Service Stats for %s: %+v", id, err)
+				}
+
+				plan.Status = resp.GeoReplication.Status
+				if v := resp.GeoReplication.LastSyncTime; v != "" {
+					lastSyncTime, err := time.Parse(http.TimeFormat, v)
+					if err != nil {
+						return fmt.Errorf("parsing the Last Sync Time %q for %s: %+v", v, id, err)
+					}
+					plan.LastSyncTime = lastSyncTime.Format(time.RFC3339)
+				}
+			} else {
+				log.Printf("[DEBUG] %s doesn't have a readable secondary location - the Geo-Replication statistics aren't available", id)
+			}
+
+			if err := metadata.Encode(&plan); err != nil {
+				return fmt.Errorf("encoding %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
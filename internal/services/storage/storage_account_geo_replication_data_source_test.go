@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type storageAccountGeoReplicationDataSource struct{}
+
+func TestAccDataSourceStorageAccountGeoReplication_locallyRedundant(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_account_geo_replication", "test")
+	d := storageAccountGeoReplicationDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: d.basic(data, "LRS"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("status").HasValue(""),
+				check.That(data.ResourceName).Key("last_sync_time").HasValue(""),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceStorageAccountGeoReplication_readAccessGeoRedundant(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_account_geo_replication", "test")
+	d := storageAccountGeoReplicationDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: d.basic(data, "RAGRS"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("status").Exists(),
+			),
+		},
+	})
+}
+
+func (d storageAccountGeoReplicationDataSource) basic(data acceptance.TestData, replicationType string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "%s"
+}
+
+data "azurerm_storage_account_geo_replication" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, replicationType)
+}
@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type storageAccountLocationDataSource struct{}
+
+var _ sdk.DataSource = storageAccountLocationDataSource{}
+
+type storageAccountLocationDataSourceModel struct {
+	StorageAccountName string `tfschema:"storage_account_name"`
+	StorageAccountId   string `tfschema:"storage_account_id"`
+	ResourceGroupName  string `tfschema:"resource_group_name"`
+	PrimaryLocation    string `tfschema:"primary_location"`
+	SecondaryLocation  string `tfschema:"secondary_location"`
+}
+
+func (r storageAccountLocationDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_account_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.StorageAccountName,
+		},
+	}
+}
+
+func (r storageAccountLocationDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_account_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"resource_group_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"primary_location": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"secondary_location": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r storageAccountLocationDataSource) ResourceType() string {
+	return "azurerm_storage_account_location"
+}
+
+func (r storageAccountLocationDataSource) ModelObject() interface{} {
+	return &storageAccountLocationDataSourceModel{}
+}
+
+func (r storageAccountLocationDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			var plan storageAccountLocationDataSourceModel
+			if err := metadata.Decode(&plan); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			// the locations are taken from the Storage Account cached by FindAccount, rather than retrieving the
+			// Storage Account again - meaning the Resource Group doesn't need to be known
+			account, err := storageClient.FindAccount(ctx, plan.StorageAccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving Storage Account %q: %+v", plan.StorageAccountName, err)
+			}
+			if account == nil {
+				return fmt.Errorf("unable to locate Storage Account %q", plan.StorageAccountName)
+			}
+
+			id, err := commonids.ParseStorageAccountID(account.ID)
+			if err != nil {
+				return err
+			}
+
+			plan.StorageAccountId = id.ID()
+			plan.ResourceGroupName = id.ResourceGroupName
+			plan.PrimaryLocation = location.Normalize(account.PrimaryLocation())
+			plan.SecondaryLocation = location.Normalize(account.SecondaryLocation())
+
+			if err := metadata.Encode(&plan); err != nil {
+				return fmt.Errorf("encoding %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type storageAccountLocationDataSource struct{}
+
+func TestAccDataSourceStorageAccountLocation_locallyRedundant(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_account_location", "test")
+	d := storageAccountLocationDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: d.basic(data, "LRS"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("storage_account_id").Exists(),
+				check.That(data.ResourceName).Key("resource_group_name").Exists(),
+				check.That(data.ResourceName).Key("primary_location").HasValue(data.Locations.Primary),
+				check.That(data.ResourceName).Key("secondary_location").HasValue(""),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceStorageAccountLocation_geoRedundant(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_account_location", "test")
+	d := storageAccountLocationDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: d.basic(data, "GRS"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("primary_location").HasValue(data.Locations.Primary),
+				check.That(data.ResourceName).Key("secondary_location").IsNotEmpty(),
+			),
+		},
+	})
+}
+
+func (d storageAccountLocationDataSource) basic(data acceptance.TestData, replicationType string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "%s"
+}
+
+data "azurerm_storage_account_location" "test" {
+  storage_account_name = azurerm_storage_account.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, replicationType)
+}
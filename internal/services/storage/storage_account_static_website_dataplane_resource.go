@@ -0,0 +1,232 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/blob/accounts"
+)
+
+type StorageAccountStaticWebsiteDataPlaneResource struct{}
+
+var _ sdk.ResourceWithUpdate = StorageAccountStaticWebsiteDataPlaneResource{}
+
+type StorageAccountStaticWebsiteDataPlaneModel struct {
+	StorageAccountId string `tfschema:"storage_account_id"`
+	IndexDocument    string `tfschema:"index_document"`
+	Error404Document string `tfschema:"error_404_document"`
+}
+
+func (r StorageAccountStaticWebsiteDataPlaneResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateStorageAccountID,
+		},
+
+		"index_document": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validate.StaticWebsiteIndexDocument,
+		},
+
+		"error_404_document": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validate.StaticWebsiteErrorDocument,
+		},
+	}
+}
+
+func (r StorageAccountStaticWebsiteDataPlaneResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r StorageAccountStaticWebsiteDataPlaneResource) ModelObject() interface{} {
+	return &StorageAccountStaticWebsiteDataPlaneModel{}
+}
+
+func (r StorageAccountStaticWebsiteDataPlaneResource) ResourceType() string {
+	return "azurerm_storage_account_static_website_dataplane"
+}
+
+func (r StorageAccountStaticWebsiteDataPlaneResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return commonids.ValidateStorageAccountID
+}
+
+func (r StorageAccountStaticWebsiteDataPlaneResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model StorageAccountStaticWebsiteDataPlaneModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := commonids.ParseStorageAccountID(model.StorageAccountId)
+			if err != nil {
+				return err
+			}
+
+			client, err := r.accountsDataPlaneClient(ctx, metadata, *id)
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.GetServiceProperties(ctx, id.StorageAccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving static website properties for %s: %+v", id, err)
+			}
+			if props := existing.StorageServiceProperties; props != nil && props.StaticWebsite != nil && props.StaticWebsite.Enabled {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			input := accounts.StorageServiceProperties{
+				StaticWebsite: &accounts.StaticWebsite{
+					Enabled:              true,
+					IndexDocument:        model.IndexDocument,
+					ErrorDocument404Path: model.Error404Document,
+				},
+			}
+			if _, err := client.SetServiceProperties(ctx, id.StorageAccountName, input); err != nil {
+				return fmt.Errorf("enabling the static website for %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r StorageAccountStaticWebsiteDataPlaneResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := commonids.ParseStorageAccountID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			account, err := metadata.Client.Storage.FindAccount(ctx, id.StorageAccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if account == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			client, err := metadata.Client.Storage.AccountsDataPlaneClient(ctx, *account)
+			if err != nil {
+				return fmt.Errorf("building Accounts Data Plane Client: %s", err)
+			}
+
+			resp, err := client.GetServiceProperties(ctx, id.StorageAccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving static website properties for %s: %+v", id, err)
+			}
+
+			props := resp.StorageServiceProperties
+			if props == nil || props.StaticWebsite == nil || !props.StaticWebsite.Enabled {
+				return metadata.MarkAsGone(id)
+			}
+
+			state := StorageAccountStaticWebsiteDataPlaneModel{
+				StorageAccountId: id.ID(),
+				IndexDocument:    props.StaticWebsite.IndexDocument,
+				Error404Document: props.StaticWebsite.ErrorDocument404Path,
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageAccountStaticWebsiteDataPlaneResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := commonids.ParseStorageAccountID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model StorageAccountStaticWebsiteDataPlaneModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client, err := r.accountsDataPlaneClient(ctx, metadata, *id)
+			if err != nil {
+				return err
+			}
+
+			input := accounts.StorageServiceProperties{
+				StaticWebsite: &accounts.StaticWebsite{
+					Enabled:              true,
+					IndexDocument:        model.IndexDocument,
+					ErrorDocument404Path: model.Error404Document,
+				},
+			}
+			if _, err := client.SetServiceProperties(ctx, id.StorageAccountName, input); err != nil {
+				return fmt.Errorf("updating the static website for %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageAccountStaticWebsiteDataPlaneResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := commonids.ParseStorageAccountID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			client, err := r.accountsDataPlaneClient(ctx, metadata, *id)
+			if err != nil {
+				return err
+			}
+
+			input := accounts.StorageServiceProperties{
+				StaticWebsite: &accounts.StaticWebsite{
+					Enabled: false,
+				},
+			}
+			if _, err := client.SetServiceProperties(ctx, id.StorageAccountName, input); err != nil {
+				return fmt.Errorf("disabling the static website for %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageAccountStaticWebsiteDataPlaneResource) accountsDataPlaneClient(ctx context.Context, metadata sdk.ResourceMetaData, id commonids.StorageAccountId) (*accounts.Client, error) {
+	account, err := metadata.Client.Storage.FindAccount(ctx, id.StorageAccountName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if account == nil {
+		return nil, fmt.Errorf("unable to locate %s", id)
+	}
+
+	client, err := metadata.Client.Storage.AccountsDataPlaneClient(ctx, *account)
+	if err != nil {
+		return nil, fmt.Errorf("building Accounts Data Plane Client: %s", err)
+	}
+
+	return client, nil
+}
@@ -0,0 +1,230 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/blob/blobs"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/blob/containers"
+)
+
+// analyticsLogsContainerName is the name of the special Container used by Storage Analytics to store
+// its logs - this can't be created through `azurerm_storage_container` since the name is reserved
+const analyticsLogsContainerName = "$logs"
+
+type storageAnalyticsLogsDataSource struct{}
+
+var _ sdk.DataSource = storageAnalyticsLogsDataSource{}
+
+type storageAnalyticsLogsDataSourceModel struct {
+	StorageAccountName string                  `tfschema:"storage_account_name"`
+	Service            string                  `tfschema:"service"`
+	Prefix             string                  `tfschema:"prefix"`
+	Logs               []analyticsLogBlobModel `tfschema:"logs"`
+}
+
+type analyticsLogBlobModel struct {
+	Name         string `tfschema:"name"`
+	Url          string `tfschema:"url"`
+	Service      string `tfschema:"service"`
+	Size         int64  `tfschema:"size"`
+	LogType      string `tfschema:"log_type"`
+	StartTime    string `tfschema:"start_time"`
+	EndTime      string `tfschema:"end_time"`
+	LastModified string `tfschema:"last_modified"`
+}
+
+func (r storageAnalyticsLogsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_account_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.StorageAccountName,
+		},
+
+		"service": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"blob",
+				"queue",
+				"table",
+			}, false),
+		},
+
+		"prefix": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r storageAnalyticsLogsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"logs": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"url": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"service": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"size": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"log_type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"start_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"end_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"last_modified": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r storageAnalyticsLogsDataSource) ResourceType() string {
+	return "azurerm_storage_analytics_logs"
+}
+
+func (r storageAnalyticsLogsDataSource) ModelObject() interface{} {
+	return &storageAnalyticsLogsDataSourceModel{}
+}
+
+func (r storageAnalyticsLogsDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			var plan storageAnalyticsLogsDataSourceModel
+			if err := metadata.Decode(&plan); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			// the `$logs` container is intentionally not validated using `validate.StorageContainerName`, which
+			// only allows the `$root` and `$web` special containers, since this is never user-specified
+			id := parse.NewStorageContainerDataPlaneId(plan.StorageAccountName, storageClient.Environment.StorageEndpointSuffix, analyticsLogsContainerName)
+
+			account, err := storageClient.FindAccount(ctx, plan.StorageAccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving Account %q for Container %q: %+v", plan.StorageAccountName, analyticsLogsContainerName, err)
+			}
+			if account == nil {
+				return fmt.Errorf("unable to locate Storage Account %q", plan.StorageAccountName)
+			}
+
+			containersClient, err := storageClient.ContainersClient(ctx, *account)
+			if err != nil {
+				return fmt.Errorf("building Containers Client: %+v", err)
+			}
+
+			blobsClient, err := storageClient.BlobsClient(ctx, *account)
+			if err != nil {
+				return fmt.Errorf("building Blobs Client: %+v", err)
+			}
+
+			// Log Blobs are named `{service}/YYYY/MM/DD/hhmm/counter.log` - so the service (when specified)
+			// forms the first segment of the prefix
+			prefix := plan.Prefix
+			if plan.Service != "" {
+				prefix = fmt.Sprintf("%s/%s", plan.Service, prefix)
+			}
+
+			input := containers.ListBlobsInput{}
+			if prefix != "" {
+				input.Prefix = pointer.To(prefix)
+			}
+
+			plan.Logs = make([]analyticsLogBlobModel, 0)
+
+			// the `$logs` container is only created once logging has been enabled for a service
+			exists, err := containersClient.Exists(ctx, account.ResourceGroup, plan.StorageAccountName, analyticsLogsContainerName)
+			if err != nil {
+				return fmt.Errorf("checking for existence of %s: %+v", id, err)
+			}
+			if exists != nil && *exists {
+				items, err := containersClient.ListBlobs(ctx, account.ResourceGroup, plan.StorageAccountName, analyticsLogsContainerName, input)
+				if err != nil {
+					return fmt.Errorf("listing Blobs within %s: %+v", id, err)
+				}
+
+				for _, item := range *items {
+					log := analyticsLogBlobModel{
+						Name:    item.Name,
+						Url:     blobsClient.GetResourceID(plan.StorageAccountName, analyticsLogsContainerName, item.Name),
+						Service: strings.Split(item.Name, "/")[0],
+					}
+
+					if props := item.Properties; props != nil {
+						log.Size = pointer.From(props.ContentLength)
+						log.LastModified = pointer.From(props.LastModified)
+					}
+
+					// the time range covered by each log is only available in the Blob's MetaData, which isn't
+					// returned when listing Blobs - so this has to be retrieved for each Blob
+					props, err := blobsClient.GetProperties(ctx, plan.StorageAccountName, analyticsLogsContainerName, item.Name, blobs.GetPropertiesInput{})
+					if err != nil {
+						return fmt.Errorf("retrieving properties for Blob %q within %s: %+v", item.Name, id, err)
+					}
+					if props.MetaData != nil {
+						log.LogType = props.MetaData["logtype"]
+						log.StartTime = props.MetaData["starttime"]
+						log.EndTime = props.MetaData["endtime"]
+					}
+
+					plan.Logs = append(plan.Logs, log)
+				}
+			}
+
+			if err := metadata.Encode(&plan); err != nil {
+				return fmt.Errorf("encoding %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type storageAnalyticsLogsDataSource struct{}
+
+func TestAccDataSourceStorageAnalyticsLogs_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_analytics_logs", "test")
+	d := storageAnalyticsLogsDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: d.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("id").Exists(),
+				check.That(data.ResourceName).Key("service").HasValue("queue"),
+				// logs are written asynchronously, so there's no guarantee any will exist yet
+				check.That(data.ResourceName).Key("logs.#").Exists(),
+			),
+		},
+	})
+}
+
+func (d storageAnalyticsLogsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+
+  queue_properties {
+    logging {
+      version               = "1.0"
+      delete                = true
+      read                  = true
+      write                 = true
+      retention_policy_days = 7
+    }
+  }
+}
+
+resource "azurerm_storage_queue" "test" {
+  name                 = "acctestqueue"
+  storage_account_name = azurerm_storage_account.test.name
+}
+
+data "azurerm_storage_analytics_logs" "test" {
+  storage_account_name = azurerm_storage_account.test.name
+  service              = "queue"
+
+  depends_on = [azurerm_storage_queue.test]
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
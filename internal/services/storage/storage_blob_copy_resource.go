@@ -0,0 +1,294 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/blob/blobs"
+)
+
+type StorageBlobCopyResource struct{}
+
+var _ sdk.Resource = StorageBlobCopyResource{}
+
+type StorageBlobCopyModel struct {
+	Name                 string `tfschema:"name"`
+	StorageAccountName   string `tfschema:"storage_account_name"`
+	StorageContainerName string `tfschema:"storage_container_name"`
+	SourceUri            string `tfschema:"source_uri"`
+	CopyId               string `tfschema:"copy_id"`
+	CopyStatus           string `tfschema:"copy_status"`
+	Url                  string `tfschema:"url"`
+}
+
+func (r StorageBlobCopyResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"storage_account_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StorageAccountName,
+		},
+
+		"storage_container_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StorageContainerName,
+		},
+
+		"source_uri": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+		},
+	}
+}
+
+func (r StorageBlobCopyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"copy_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"copy_status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"url": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r StorageBlobCopyResource) ModelObject() interface{} {
+	return &StorageBlobCopyModel{}
+}
+
+func (r StorageBlobCopyResource) ResourceType() string {
+	return "azurerm_storage_blob_copy"
+}
+
+func (r StorageBlobCopyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.StorageBlobDataPlaneID
+}
+
+func (r StorageBlobCopyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model StorageBlobCopyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client, err := r.blobsClient(ctx, metadata, model.StorageAccountName)
+			if err != nil {
+				return err
+			}
+
+			id := client.GetResourceID(model.StorageAccountName, model.StorageContainerName, model.Name)
+
+			existing, err := client.GetProperties(ctx, model.StorageAccountName, model.StorageContainerName, model.Name, blobs.GetPropertiesInput{})
+			if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for the presence of an existing Blob %q (Container %q / Account %q): %+v", model.Name, model.StorageContainerName, model.StorageAccountName, err)
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), blobId(id))
+			}
+
+			input := blobs.CopyInput{
+				CopySource: model.SourceUri,
+			}
+			result, err := client.Copy(ctx, model.StorageAccountName, model.StorageContainerName, model.Name, input)
+			if err != nil {
+				return fmt.Errorf("copying %q into Blob %q (Container %q / Account %q): %+v", model.SourceUri, model.Name, model.StorageContainerName, model.StorageAccountName, err)
+			}
+
+			// the Blob exists once the copy has been started, so the ID is set prior to waiting for the copy to complete
+			// such that the Blob is tracked (and can be removed) should the copy fail
+			metadata.ResourceData.SetId(id)
+
+			deadline, ok := ctx.Deadline()
+			if !ok {
+				return fmt.Errorf("internal-error: context had no deadline")
+			}
+
+			log.Printf("[DEBUG] Waiting for Copy %q into Blob %q (Container %q / Account %q) to complete", result.CopyID, model.Name, model.StorageContainerName, model.StorageAccountName)
+			stateConf := &pluginsdk.StateChangeConf{
+				Pending:    []string{string(blobs.Pending)},
+				Target:     []string{string(blobs.Success)},
+				Refresh:    storageBlobCopyRefreshFunc(ctx, client, model.StorageAccountName, model.StorageContainerName, model.Name),
+				MinTimeout: 10 * time.Second,
+				Timeout:    time.Until(deadline),
+			}
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+				return fmt.Errorf("waiting for Copy %q into Blob %q (Container %q / Account %q) to complete: %+v", result.CopyID, model.Name, model.StorageContainerName, model.StorageAccountName, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageBlobCopyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := blobs.ParseResourceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			account, err := metadata.Client.Storage.FindAccount(ctx, id.AccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving Account %q for Blob %q (Container %q): %+v", id.AccountName, id.BlobName, id.ContainerName, err)
+			}
+			if account == nil {
+				log.Printf("[DEBUG] Unable to locate Account %q for Blob %q (Container %q) - assuming removed & removing from state", id.AccountName, id.BlobName, id.ContainerName)
+				return metadata.MarkAsGone(blobId(metadata.ResourceData.Id()))
+			}
+
+			client, err := metadata.Client.Storage.BlobsClient(ctx, *account)
+			if err != nil {
+				return fmt.Errorf("building Blobs Client: %+v", err)
+			}
+
+			props, err := client.GetProperties(ctx, id.AccountName, id.ContainerName, id.BlobName, blobs.GetPropertiesInput{})
+			if err != nil {
+				if utils.ResponseWasNotFound(props.Response) {
+					return metadata.MarkAsGone(blobId(metadata.ResourceData.Id()))
+				}
+				return fmt.Errorf("retrieving Blob %q (Container %q / Account %q): %+v", id.BlobName, id.ContainerName, id.AccountName, err)
+			}
+
+			state := StorageBlobCopyModel{
+				Name:                 id.BlobName,
+				StorageAccountName:   id.AccountName,
+				StorageContainerName: id.ContainerName,
+				CopyId:               props.CopyID,
+				CopyStatus:           string(props.CopyStatus),
+				Url:                  metadata.ResourceData.Id(),
+			}
+
+			// the source returned by the API may differ from the value specified (e.g. the signature of a SAS Token is
+			// redacted), so this is only used when importing - additionally this is only returned until the Blob is modified
+			state.SourceUri = metadata.ResourceData.Get("source_uri").(string)
+			if state.SourceUri == "" {
+				state.SourceUri = props.CopySource
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageBlobCopyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := blobs.ParseResourceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			client, err := r.blobsClient(ctx, metadata, id.AccountName)
+			if err != nil {
+				return err
+			}
+
+			// a copy which is still pending has to be aborted before the Blob can be deleted
+			props, err := client.GetProperties(ctx, id.AccountName, id.ContainerName, id.BlobName, blobs.GetPropertiesInput{})
+			if err != nil {
+				if utils.ResponseWasNotFound(props.Response) {
+					return nil
+				}
+				return fmt.Errorf("retrieving Blob %q (Container %q / Account %q): %+v", id.BlobName, id.ContainerName, id.AccountName, err)
+			}
+			if props.CopyStatus == blobs.Pending {
+				input := blobs.AbortCopyInput{
+					CopyID: props.CopyID,
+				}
+				if _, err := client.AbortCopy(ctx, id.AccountName, id.ContainerName, id.BlobName, input); err != nil {
+					return fmt.Errorf("aborting Copy %q into Blob %q (Container %q / Account %q): %+v", props.CopyID, id.BlobName, id.ContainerName, id.AccountName, err)
+				}
+			}
+
+			input := blobs.DeleteInput{
+				DeleteSnapshots: true,
+			}
+			if _, err := client.Delete(ctx, id.AccountName, id.ContainerName, id.BlobName, input); err != nil {
+				return fmt.Errorf("deleting Blob %q (Container %q / Account %q): %+v", id.BlobName, id.ContainerName, id.AccountName, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageBlobCopyResource) blobsClient(ctx context.Context, metadata sdk.ResourceMetaData, accountName string) (*blobs.Client, error) {
+	account, err := metadata.Client.Storage.FindAccount(ctx, accountName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Account %q: %+v", accountName, err)
+	}
+	if account == nil {
+		return nil, fmt.Errorf("unable to locate Storage Account %q", accountName)
+	}
+
+	client, err := metadata.Client.Storage.BlobsClient(ctx, *account)
+	if err != nil {
+		return nil, fmt.Errorf("building Blobs Client: %+v", err)
+	}
+
+	return client, nil
+}
+
+func storageBlobCopyRefreshFunc(ctx context.Context, client *blobs.Client, accountName, containerName, blobName string) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		props, err := client.GetProperties(ctx, accountName, containerName, blobName, blobs.GetPropertiesInput{})
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving Blob %q (Container %q / Account %q): %+v", blobName, containerName, accountName, err)
+		}
+
+		switch props.CopyStatus {
+		case blobs.Aborted:
+			return nil, "", fmt.Errorf("the copy was aborted: %s", props.CopyStatusDescription)
+		case blobs.Failed:
+			return nil, "", fmt.Errorf("the copy failed: %s", props.CopyStatusDescription)
+		}
+
+		return props, string(props.CopyStatus), nil
+	}
+}
+
+// blobId allows a Blob's Data Plane URL to be used where a `resourceids.Id` is expected
+type blobId string
+
+func (id blobId) ID() string {
+	return string(id)
+}
+
+func (id blobId) String() string {
+	return fmt.Sprintf("Storage Blob %q", string(id))
+}
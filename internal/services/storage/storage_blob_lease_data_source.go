@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/blob/blobs"
+)
+
+type storageBlobLeaseDataSource struct{}
+
+var _ sdk.DataSource = storageBlobLeaseDataSource{}
+
+type storageBlobLeaseDataSourceModel struct {
+	StorageBlobId string `tfschema:"storage_blob_id"`
+	LeaseStatus   string `tfschema:"lease_status"`
+	LeaseState    string `tfschema:"lease_state"`
+	LeaseDuration string `tfschema:"lease_duration"`
+}
+
+func (r storageBlobLeaseDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_blob_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.StorageBlobDataPlaneID,
+		},
+	}
+}
+
+func (r storageBlobLeaseDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"lease_status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"lease_state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"lease_duration": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r storageBlobLeaseDataSource) ResourceType() string {
+	return "azurerm_storage_blob_lease"
+}
+
+func (r storageBlobLeaseDataSource) ModelObject() interface{} {
+	return &storageBlobLeaseDataSourceModel{}
+}
+
+func (r storageBlobLeaseDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			var plan storageBlobLeaseDataSourceModel
+			if err := metadata.Decode(&plan); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			id, err := blobs.ParseResourceID(plan.StorageBlobId)
+			if err != nil {
+				return err
+			}
+
+			account, err := storageClient.FindAccount(ctx, id.AccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving Account %q for Blob %q (Container %q): %+v", id.AccountName, id.BlobName, id.ContainerName, err)
+			}
+			if account == nil {
+				return fmt.Errorf("unable to locate Storage Account %q", id.AccountName)
+			}
+
+			client, err := storageClient.BlobsClient(ctx, *account)
+			if err != nil {
+				return fmt.Errorf("building Blobs Client: %+v", err)
+			}
+
+			props, err := client.GetProperties(ctx, id.AccountName, id.ContainerName, id.BlobName, blobs.GetPropertiesInput{})
+			if err != nil {
+				if utils.ResponseWasNotFound(props.Response) {
+					return fmt.Errorf("the Blob %q was not found in Container %q / Account %q", id.BlobName, id.ContainerName, id.AccountName)
+				}
+
+				return fmt.Errorf("retrieving properties for Blob %q (Container %q / Account %q): %+v", id.BlobName, id.ContainerName, id.AccountName, err)
+			}
+
+			plan.LeaseStatus = string(props.LeaseStatus)
+			plan.LeaseState = string(props.LeaseState)
+			// the Lease Duration is only returned whilst the Blob is leased
+			plan.LeaseDuration = string(props.LeaseDuration)
+
+			if err := metadata.Encode(&plan); err != nil {
+				return fmt.Errorf("encoding %s: %+v", blobId(plan.StorageBlobId), err)
+			}
+
+			metadata.SetID(blobId(plan.StorageBlobId))
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type storageBlobLeaseDataSource struct{}
+
+func TestAccDataSourceStorageBlobLease_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_blob_lease", "test")
+	d := storageBlobLeaseDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: d.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("lease_status").HasValue("unlocked"),
+				check.That(data.ResourceName).Key("lease_state").HasValue("available"),
+				check.That(data.ResourceName).Key("lease_duration").HasValue(""),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceStorageBlobLease_notFound(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_blob_lease", "test")
+	d := storageBlobLeaseDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config:      d.notFound(data),
+			ExpectError: regexp.MustCompile("was not found in Container"),
+		},
+	})
+}
+
+func (d storageBlobLeaseDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "test"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
+func (d storageBlobLeaseDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "terraform.tfstate"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Block"
+  source_content         = "{}"
+}
+
+data "azurerm_storage_blob_lease" "test" {
+  storage_blob_id = azurerm_storage_blob.test.id
+}
+`, d.template(data))
+}
+
+func (d storageBlobLeaseDataSource) notFound(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_storage_blob_lease" "test" {
+  storage_blob_id = "${azurerm_storage_container.test.id}/does-not-exist"
+}
+`, d.template(data))
+}
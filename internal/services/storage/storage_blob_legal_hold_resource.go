@@ -0,0 +1,237 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/blob/blobs"
+)
+
+type StorageBlobLegalHoldResource struct{}
+
+var _ sdk.Resource = StorageBlobLegalHoldResource{}
+
+type StorageBlobLegalHoldModel struct {
+	StorageBlobId string `tfschema:"storage_blob_id"`
+	VersionId     string `tfschema:"version_id"`
+}
+
+func (r StorageBlobLegalHoldResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_blob_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StorageBlobDataPlaneID,
+		},
+
+		"version_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r StorageBlobLegalHoldResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r StorageBlobLegalHoldResource) ModelObject() interface{} {
+	return &StorageBlobLegalHoldModel{}
+}
+
+func (r StorageBlobLegalHoldResource) ResourceType() string {
+	return "azurerm_storage_blob_legal_hold"
+}
+
+func (r StorageBlobLegalHoldResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.StorageBlobDataPlaneID
+}
+
+func (r StorageBlobLegalHoldResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			var model StorageBlobLegalHoldModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			blob, err := blobs.ParseResourceID(model.StorageBlobId)
+			if err != nil {
+				return err
+			}
+
+			id := model.StorageBlobId
+			if model.VersionId != "" {
+				id = fmt.Sprintf("%s?versionid=%s", model.StorageBlobId, url.QueryEscape(model.VersionId))
+			}
+
+			account, err := storageClient.FindAccount(ctx, blob.AccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving Account %q for Blob %q (Container %q): %+v", blob.AccountName, blob.BlobName, blob.ContainerName, err)
+			}
+			if account == nil {
+				return fmt.Errorf("unable to locate Storage Account %q", blob.AccountName)
+			}
+
+			// a Legal Hold can only be set on a Blob when version-level immutability support is enabled, which
+			// is checked up-front since otherwise the Data Plane API returns a rather opaque error
+			accountId, err := commonids.ParseStorageAccountID(account.ID)
+			if err != nil {
+				return err
+			}
+			containerId := commonids.NewStorageContainerID(accountId.SubscriptionId, accountId.ResourceGroupName, accountId.StorageAccountName, blob.ContainerName)
+			container, err := storageClient.ResourceManager.BlobContainers.Get(ctx, containerId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", containerId, err)
+			}
+			versionLevelImmutabilityEnabled := false
+			if props := container.Model; props != nil && props.Properties != nil && props.Properties.ImmutableStorageWithVersioning != nil {
+				versionLevelImmutabilityEnabled = pointer.From(props.Properties.ImmutableStorageWithVersioning.Enabled)
+			}
+			if !versionLevelImmutabilityEnabled {
+				return fmt.Errorf("a Legal Hold can only be set on a Blob when version-level immutability support is enabled - but this isn't enabled for %s (or Storage Account %q)", containerId, blob.AccountName)
+			}
+
+			client, err := storageClient.BlobLegalHoldClient(ctx, *account)
+			if err != nil {
+				return fmt.Errorf("building Blob Legal Hold Client: %+v", err)
+			}
+
+			existing, err := client.GetLegalHold(ctx, blob.AccountName, blob.ContainerName, blob.BlobName, model.VersionId)
+			if err != nil {
+				return fmt.Errorf("retrieving the Legal Hold for %s: %+v", blobId(id), err)
+			}
+			if existing.LegalHold {
+				return metadata.ResourceRequiresImport(r.ResourceType(), blobId(id))
+			}
+
+			if _, err := client.SetLegalHold(ctx, blob.AccountName, blob.ContainerName, blob.BlobName, model.VersionId, true); err != nil {
+				return fmt.Errorf("setting the Legal Hold for %s: %+v", blobId(id), err)
+			}
+
+			metadata.SetID(blobId(id))
+			return nil
+		},
+	}
+}
+
+func (r StorageBlobLegalHoldResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			blobUrl, versionId, err := parseStorageBlobLegalHoldID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+			blob, err := blobs.ParseResourceID(blobUrl)
+			if err != nil {
+				return err
+			}
+
+			account, err := storageClient.FindAccount(ctx, blob.AccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving Account %q for Blob %q (Container %q): %+v", blob.AccountName, blob.BlobName, blob.ContainerName, err)
+			}
+			if account == nil {
+				return metadata.MarkAsGone(blobId(metadata.ResourceData.Id()))
+			}
+
+			client, err := storageClient.BlobLegalHoldClient(ctx, *account)
+			if err != nil {
+				return fmt.Errorf("building Blob Legal Hold Client: %+v", err)
+			}
+
+			result, err := client.GetLegalHold(ctx, blob.AccountName, blob.ContainerName, blob.BlobName, versionId)
+			if err != nil {
+				if utils.ResponseWasNotFound(result.Response) {
+					return metadata.MarkAsGone(blobId(metadata.ResourceData.Id()))
+				}
+				return fmt.Errorf("retrieving the Legal Hold for %s: %+v", blobId(metadata.ResourceData.Id()), err)
+			}
+
+			// the Legal Hold having been cleared outside of Terraform is drift, which recreates this resource
+			if !result.LegalHold {
+				return metadata.MarkAsGone(blobId(metadata.ResourceData.Id()))
+			}
+
+			state := StorageBlobLegalHoldModel{
+				StorageBlobId: blobUrl,
+				VersionId:     versionId,
+			}
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageBlobLegalHoldResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			blobUrl, versionId, err := parseStorageBlobLegalHoldID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+			blob, err := blobs.ParseResourceID(blobUrl)
+			if err != nil {
+				return err
+			}
+
+			account, err := storageClient.FindAccount(ctx, blob.AccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving Account %q for Blob %q (Container %q): %+v", blob.AccountName, blob.BlobName, blob.ContainerName, err)
+			}
+			if account == nil {
+				return fmt.Errorf("unable to locate Storage Account %q", blob.AccountName)
+			}
+
+			client, err := storageClient.BlobLegalHoldClient(ctx, *account)
+			if err != nil {
+				return fmt.Errorf("building Blob Legal Hold Client: %+v", err)
+			}
+
+			if resp, err := client.SetLegalHold(ctx, blob.AccountName, blob.ContainerName, blob.BlobName, versionId, false); err != nil {
+				if utils.ResponseWasNotFound(resp) {
+					return nil
+				}
+				return fmt.Errorf("clearing the Legal Hold for %s: %+v", blobId(metadata.ResourceData.Id()), err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// parseStorageBlobLegalHoldID returns the URL of the Blob and the Version ID (if any) from the ID, which is the
+// URL of the Blob - with a `versionid` query string parameter when the Legal Hold is on a Version of the Blob
+func parseStorageBlobLegalHoldID(input string) (string, string, error) {
+	uri, err := url.Parse(input)
+	if err != nil {
+		return "", "", fmt.Errorf("parsing %q as a URL: %+v", input, err)
+	}
+
+	versionId := uri.Query().Get("versionid")
+	uri.RawQuery = ""
+	return uri.String(), versionId, nil
+}
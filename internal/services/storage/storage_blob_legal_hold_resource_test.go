@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/blob/blobs"
+)
+
+type StorageBlobLegalHoldResource struct{}
+
+func TestAccStorageBlobLegalHold_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob_legal_hold", "test")
+	r := StorageBlobLegalHoldResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageBlobLegalHold_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob_legal_hold", "test")
+	r := StorageBlobLegalHoldResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccStorageBlobLegalHold_versionLevelImmutabilityDisabled(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob_legal_hold", "test")
+	r := StorageBlobLegalHoldResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.versionLevelImmutabilityDisabled(data),
+			ExpectError: regexp.MustCompile("version-level immutability support is enabled"),
+		},
+	})
+}
+
+func (r StorageBlobLegalHoldResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	uri, err := url.Parse(state.ID)
+	if err != nil {
+		return nil, err
+	}
+	versionId := uri.Query().Get("versionid")
+	uri.RawQuery = ""
+
+	id, err := blobs.ParseResourceID(uri.String())
+	if err != nil {
+		return nil, err
+	}
+	account, err := client.Storage.FindAccount(ctx, id.AccountName)
+	if err != nil {
+		return nil, err
+	}
+	if account == nil {
+		return nil, fmt.Errorf("unable to locate Account %q for Blob %q (Container %q)", id.AccountName, id.BlobName, id.ContainerName)
+	}
+	legalHoldClient, err := client.Storage.BlobLegalHoldClient(ctx, *account)
+	if err != nil {
+		return nil, fmt.Errorf("building Blob Legal Hold Client: %+v", err)
+	}
+	resp, err := legalHoldClient.GetLegalHold(ctx, id.AccountName, id.ContainerName, id.BlobName, versionId)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving the Legal Hold for Blob %q (Container %q / Account %q): %+v", id.BlobName, id.ContainerName, id.AccountName, err)
+	}
+	return utils.Bool(resp.LegalHold), nil
+}
+
+func (r StorageBlobLegalHoldResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blob_legal_hold" "test" {
+  storage_blob_id = azurerm_storage_blob.test.id
+}
+`, r.template(data, true))
+}
+
+func (r StorageBlobLegalHoldResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blob_legal_hold" "import" {
+  storage_blob_id = azurerm_storage_blob_legal_hold.test.storage_blob_id
+}
+`, r.basic(data))
+}
+
+func (r StorageBlobLegalHoldResource) versionLevelImmutabilityDisabled(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blob_legal_hold" "test" {
+  storage_blob_id = azurerm_storage_blob.test.id
+}
+`, r.template(data, false))
+}
+
+func (r StorageBlobLegalHoldResource) template(data acceptance.TestData, versionLevelImmutability bool) string {
+	immutabilityPolicy := ""
+	if versionLevelImmutability {
+		// a Disabled policy enables version-level immutability support, without a default retention period
+		// (which would otherwise prevent the Blob from being deleted at the end of the test)
+		immutabilityPolicy = `
+  immutability_policy {
+    allow_protected_append_writes = false
+    period_since_creation_in_days = 1
+    state                         = "Disabled"
+  }
+`
+	}
+
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+
+  blob_properties {
+    versioning_enabled = true
+  }
+%s
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "test"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "example.txt"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Block"
+  source_content         = "hello"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, immutabilityPolicy)
+}
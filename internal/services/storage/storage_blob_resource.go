@@ -87,14 +87,10 @@ func resourceStorageBlob() *pluginsdk.Resource {
 			},
 
 			"access_tier": {
-				Type:     pluginsdk.TypeString,
-				Optional: true,
-				Computed: true,
-				ValidateFunc: validation.StringInSlice([]string{
-					string(blobs.Archive),
-					string(blobs.Cool),
-					string(blobs.Hot),
-				}, false),
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.StorageBlobAccessTier,
 			},
 
 			"content_type": {
@@ -142,15 +138,19 @@ func resourceStorageBlob() *pluginsdk.Resource {
 			},
 
 			"parallelism": {
-				// TODO: @tombuildsstuff - a note this only works for Page blobs
 				Type:         pluginsdk.TypeInt,
 				Optional:     true,
 				Default:      8,
 				ForceNew:     true,
-				ValidateFunc: validation.IntAtLeast(1),
+				ValidateFunc: validation.IntBetween(1, 64),
 			},
 
 			"metadata": MetaDataComputedSchema(),
+
+			"authentication_method": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
 		},
 
 		CustomizeDiff: func(ctx context.Context, diff *pluginsdk.ResourceDiff, i interface{}) error {
@@ -159,6 +159,16 @@ func resourceStorageBlob() *pluginsdk.Resource {
 					return fmt.Errorf(`"source" must be aligned to 512-byte boundary for "type" set to "Page"`)
 				}
 			}
+
+			// the properties which can be set differ between the types of Blob - these are only checked when they're
+			// changing, so that existing Blobs which specify an unused property aren't affected
+			blobType := diff.Get("type").(string)
+			if size := diff.Get("size").(int); size != 0 && blobType != "Page" && (diff.HasChange("size") || diff.HasChange("type")) {
+				return fmt.Errorf("`size` can only be specified when `type` is set to `Page`, since the size of %s Blobs is determined by their content", blobType)
+			}
+			if accessTier := diff.GetRawConfig().GetAttr("access_tier"); !accessTier.IsNull() && blobType != "Block" && (diff.HasChange("access_tier") || diff.HasChange("type")) {
+				return fmt.Errorf("`access_tier` can only be specified when `type` is set to `Block`, since %s Blobs don't support Access Tiers", blobType)
+			}
 			return nil
 		},
 	}
@@ -370,6 +380,7 @@ func resourceStorageBlobRead(d *pluginsdk.ResourceData, meta interface{}) error
 
 	d.Set("type", strings.TrimSuffix(string(props.BlobType), "Blob"))
 	d.Set("url", d.Id())
+	d.Set("authentication_method", storageClient.DataPlaneAuthenticationMethod())
 
 	if err := d.Set("metadata", FlattenMetaData(props.MetaData)); err != nil {
 		return fmt.Errorf("setting `metadata`: %+v", err)
@@ -63,6 +63,22 @@ func TestAccStorageBlob_appendEmptyMetaData(t *testing.T) {
 	})
 }
 
+func TestAccStorageBlob_appendUnsupportedProperties(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob", "test")
+	r := StorageBlobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.appendWithSize(data),
+			ExpectError: regexp.MustCompile("`size` can only be specified when `type` is set to `Page`"),
+		},
+		{
+			Config:      r.appendWithAccessTier(data),
+			ExpectError: regexp.MustCompile("`access_tier` can only be specified when `type` is set to `Block`"),
+		},
+	})
+}
+
 func TestAccStorageBlob_blockEmpty(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_storage_blob", "test")
 	r := StorageBlobResource{}
@@ -138,6 +154,36 @@ func TestAccStorageBlob_blockEmptyAccessTier(t *testing.T) {
 	})
 }
 
+func TestAccStorageBlob_blockEmptyColdAccessTier(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob", "test")
+	r := StorageBlobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.blockEmptyAccessTier(data, blobs.AccessTier("Cold")),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("access_tier").HasValue("Cold"),
+			),
+		},
+		data.ImportStep("parallelism", "size", "type"),
+		{
+			Config: r.blockEmptyAccessTier(data, blobs.Hot),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("access_tier").HasValue("Hot"),
+			),
+		},
+		{
+			Config: r.blockEmptyAccessTier(data, blobs.AccessTier("Cold")),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("access_tier").HasValue("Cold"),
+			),
+		},
+	})
+}
+
 func TestAccStorageBlob_blockFromInlineContent(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_storage_blob", "test")
 	r := StorageBlobResource{}
@@ -222,6 +268,30 @@ func TestAccStorageBlob_blockFromLocalFile(t *testing.T) {
 	})
 }
 
+func TestAccStorageBlob_blockFromLocalFileParallelism(t *testing.T) {
+	sourceBlob, err := os.CreateTemp("", "")
+	if err != nil {
+		t.Fatalf("Failed to create local source blob file")
+	}
+
+	if err := populateTempFile(sourceBlob); err != nil {
+		t.Fatalf("Error populating temp file: %s", err)
+	}
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob", "test")
+	r := StorageBlobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.blockFromLocalBlobParallelism(data, sourceBlob.Name(), 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.blobMatchesFile(blobs.BlockBlob, sourceBlob.Name())),
+			),
+		},
+		data.ImportStep("parallelism", "size", "source", "type"),
+	})
+}
+
 func TestAccStorageBlob_blockFromLocalFileWithContentMd5(t *testing.T) {
 	sourceBlob, err := os.CreateTemp("", "")
 	if err != nil {
@@ -606,6 +676,40 @@ resource "azurerm_storage_blob" "test" {
 `, template)
 }
 
+func (r StorageBlobResource) appendWithSize(data acceptance.TestData) string {
+	template := r.template(data, "private")
+	return fmt.Sprintf(`
+provider "azurerm" {}
+
+%s
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "example.vhd"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Append"
+  size                   = 5120
+}
+`, template)
+}
+
+func (r StorageBlobResource) appendWithAccessTier(data acceptance.TestData) string {
+	template := r.template(data, "private")
+	return fmt.Sprintf(`
+provider "azurerm" {}
+
+%s
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "example.vhd"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Append"
+  access_tier            = "Cool"
+}
+`, template)
+}
+
 func (r StorageBlobResource) blockEmpty(data acceptance.TestData) string {
 	template := r.template(data, "private")
 	return fmt.Sprintf(`
@@ -803,6 +907,26 @@ resource "azurerm_storage_blob" "test" {
 `, template, fileName)
 }
 
+func (r StorageBlobResource) blockFromLocalBlobParallelism(data acceptance.TestData, fileName string, parallelism int) string {
+	template := r.template(data, "private")
+	return fmt.Sprintf(`
+%s
+
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "example.vhd"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Block"
+  source                 = "%s"
+  parallelism            = %d
+}
+`, template, fileName, parallelism)
+}
+
 func (r StorageBlobResource) contentMd5ForLocalFile(data acceptance.TestData, fileName string) string {
 	template := r.template(data, "blob")
 	return fmt.Sprintf(`
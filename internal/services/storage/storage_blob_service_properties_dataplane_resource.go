@@ -0,0 +1,248 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	intStor "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type StorageBlobServicePropertiesDataPlaneResource struct{}
+
+var _ sdk.ResourceWithUpdate = StorageBlobServicePropertiesDataPlaneResource{}
+
+type StorageBlobServicePropertiesDataPlaneModel struct {
+	StorageAccountId      string                                   `tfschema:"storage_account_id"`
+	DeleteRetentionPolicy []StorageBlobServiceDeleteRetentionModel `tfschema:"delete_retention_policy"`
+}
+
+type StorageBlobServiceDeleteRetentionModel struct {
+	Days int `tfschema:"days"`
+}
+
+func (r StorageBlobServicePropertiesDataPlaneResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateStorageAccountID,
+		},
+
+		"delete_retention_policy": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"days": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      7,
+						ValidateFunc: validation.IntBetween(1, 365),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r StorageBlobServicePropertiesDataPlaneResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r StorageBlobServicePropertiesDataPlaneResource) ModelObject() interface{} {
+	return &StorageBlobServicePropertiesDataPlaneModel{}
+}
+
+func (r StorageBlobServicePropertiesDataPlaneResource) ResourceType() string {
+	return "azurerm_storage_blob_service_properties_dataplane"
+}
+
+func (r StorageBlobServicePropertiesDataPlaneResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return commonids.ValidateStorageAccountID
+}
+
+func (r StorageBlobServicePropertiesDataPlaneResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model StorageBlobServicePropertiesDataPlaneModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := commonids.ParseStorageAccountID(model.StorageAccountId)
+			if err != nil {
+				return err
+			}
+
+			account, err := metadata.Client.Storage.FindAccount(ctx, id.StorageAccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if account == nil {
+				return fmt.Errorf("unable to locate %s", id)
+			}
+
+			accountsClient, err := metadata.Client.Storage.AccountsDataPlaneClient(ctx, *account)
+			if err != nil {
+				return fmt.Errorf("building Accounts Data Plane Client: %s", err)
+			}
+
+			existing, err := accountsClient.GetServiceProperties(ctx, id.StorageAccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving blob service properties for %s: %+v", id, err)
+			}
+			if props := existing.StorageServiceProperties; props != nil && props.DeleteRetentionPolicy != nil && props.DeleteRetentionPolicy.Enabled {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			client, err := metadata.Client.Storage.BlobServicePropertiesClient(ctx, *account)
+			if err != nil {
+				return fmt.Errorf("building Blob Service Properties Client: %s", err)
+			}
+
+			enabled, days := expandStorageBlobServiceDeleteRetention(model.DeleteRetentionPolicy)
+			if _, err := client.SetDeleteRetentionPolicy(ctx, id.StorageAccountName, enabled, days); err != nil {
+				return fmt.Errorf("setting the delete retention policy for %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r StorageBlobServicePropertiesDataPlaneResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := commonids.ParseStorageAccountID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			account, err := metadata.Client.Storage.FindAccount(ctx, id.StorageAccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if account == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			client, err := metadata.Client.Storage.AccountsDataPlaneClient(ctx, *account)
+			if err != nil {
+				return fmt.Errorf("building Accounts Data Plane Client: %s", err)
+			}
+
+			resp, err := client.GetServiceProperties(ctx, id.StorageAccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving blob service properties for %s: %+v", id, err)
+			}
+
+			state := StorageBlobServicePropertiesDataPlaneModel{
+				StorageAccountId:      id.ID(),
+				DeleteRetentionPolicy: []StorageBlobServiceDeleteRetentionModel{},
+			}
+			if props := resp.StorageServiceProperties; props != nil && props.DeleteRetentionPolicy != nil && props.DeleteRetentionPolicy.Enabled {
+				state.DeleteRetentionPolicy = []StorageBlobServiceDeleteRetentionModel{
+					{
+						Days: int(props.DeleteRetentionPolicy.Days),
+					},
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageBlobServicePropertiesDataPlaneResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := commonids.ParseStorageAccountID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model StorageBlobServicePropertiesDataPlaneModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client, err := r.blobServicePropertiesClient(ctx, metadata, *id)
+			if err != nil {
+				return err
+			}
+
+			if metadata.ResourceData.HasChange("delete_retention_policy") {
+				enabled, days := expandStorageBlobServiceDeleteRetention(model.DeleteRetentionPolicy)
+				if _, err := client.SetDeleteRetentionPolicy(ctx, id.StorageAccountName, enabled, days); err != nil {
+					return fmt.Errorf("updating the delete retention policy for %s: %+v", id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageBlobServicePropertiesDataPlaneResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := commonids.ParseStorageAccountID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			client, err := r.blobServicePropertiesClient(ctx, metadata, *id)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.SetDeleteRetentionPolicy(ctx, id.StorageAccountName, false, 0); err != nil {
+				return fmt.Errorf("disabling the delete retention policy for %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageBlobServicePropertiesDataPlaneResource) blobServicePropertiesClient(ctx context.Context, metadata sdk.ResourceMetaData, id commonids.StorageAccountId) (*intStor.BlobServicePropertiesClient, error) {
+	account, err := metadata.Client.Storage.FindAccount(ctx, id.StorageAccountName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if account == nil {
+		return nil, fmt.Errorf("unable to locate %s", id)
+	}
+
+	client, err := metadata.Client.Storage.BlobServicePropertiesClient(ctx, *account)
+	if err != nil {
+		return nil, fmt.Errorf("building Blob Service Properties Client: %s", err)
+	}
+
+	return client, nil
+}
+
+// expandStorageBlobServiceDeleteRetention returns whether the Delete Retention Policy is enabled, and the number
+// of days deleted Blobs are retained for - omitting the `delete_retention_policy` block disables the policy
+func expandStorageBlobServiceDeleteRetention(input []StorageBlobServiceDeleteRetentionModel) (bool, int) {
+	if len(input) == 0 {
+		return false, 0
+	}
+
+	return true, input[0].Days
+}
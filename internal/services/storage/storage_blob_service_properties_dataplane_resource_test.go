@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StorageBlobServicePropertiesDataPlaneResource struct{}
+
+func TestAccStorageBlobServicePropertiesDataPlane_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob_service_properties_dataplane", "test")
+	r := StorageBlobServicePropertiesDataPlaneResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.deleteRetentionPolicy(data, 7),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("delete_retention_policy.0.days").HasValue("7"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageBlobServicePropertiesDataPlane_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob_service_properties_dataplane", "test")
+	r := StorageBlobServicePropertiesDataPlaneResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.deleteRetentionPolicy(data, 7),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccStorageBlobServicePropertiesDataPlane_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob_service_properties_dataplane", "test")
+	r := StorageBlobServicePropertiesDataPlaneResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.deleteRetentionPolicy(data, 7),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.deleteRetentionPolicy(data, 365),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("delete_retention_policy.0.days").HasValue("365"),
+			),
+		},
+		data.ImportStep(),
+		{
+			// removing the `delete_retention_policy` block disables the policy
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("delete_retention_policy.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r StorageBlobServicePropertiesDataPlaneResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := commonids.ParseStorageAccountID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := client.Storage.FindAccount(ctx, id.StorageAccountName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if account == nil {
+		return utils.Bool(false), nil
+	}
+
+	accountsClient, err := client.Storage.AccountsDataPlaneClient(ctx, *account)
+	if err != nil {
+		return nil, fmt.Errorf("building Accounts Data Plane Client: %+v", err)
+	}
+
+	resp, err := accountsClient.GetServiceProperties(ctx, id.StorageAccountName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving blob service properties for %s: %+v", id, err)
+	}
+
+	props := resp.StorageServiceProperties
+	return utils.Bool(props != nil && props.DeleteRetentionPolicy != nil && props.DeleteRetentionPolicy.Enabled), nil
+}
+
+func (r StorageBlobServicePropertiesDataPlaneResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blob_service_properties_dataplane" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+}
+`, r.template(data))
+}
+
+func (r StorageBlobServicePropertiesDataPlaneResource) deleteRetentionPolicy(data acceptance.TestData, days int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blob_service_properties_dataplane" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+
+  delete_retention_policy {
+    days = %d
+  }
+}
+`, r.template(data), days)
+}
+
+func (r StorageBlobServicePropertiesDataPlaneResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blob_service_properties_dataplane" "import" {
+  storage_account_id = azurerm_storage_blob_service_properties_dataplane.test.storage_account_id
+
+  delete_retention_policy {
+    days = 7
+  }
+}
+`, r.deleteRetentionPolicy(data, 7))
+}
+
+func (r StorageBlobServicePropertiesDataPlaneResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_kind             = "StorageV2"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+
+  lifecycle {
+    ignore_changes = [blob_properties]
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/blob/blobs"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/blob/containers"
+)
+
+type storageBlobsDataSource struct{}
+
+var _ sdk.DataSource = storageBlobsDataSource{}
+
+type storageBlobsDataSourceModel struct {
+	StorageContainerId string      `tfschema:"storage_container_id"`
+	Prefix             string      `tfschema:"prefix"`
+	IncludeMetadata    bool        `tfschema:"include_metadata"`
+	Blobs              []blobModel `tfschema:"blobs"`
+}
+
+type blobModel struct {
+	Name         string            `tfschema:"name"`
+	Url          string            `tfschema:"url"`
+	Size         int64             `tfschema:"size"`
+	ContentType  string            `tfschema:"content_type"`
+	AccessTier   string            `tfschema:"access_tier"`
+	LastModified string            `tfschema:"last_modified"`
+	Metadata     map[string]string `tfschema:"metadata"`
+}
+
+func (r storageBlobsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_container_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.StorageContainerDataPlaneID,
+		},
+
+		"prefix": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"include_metadata": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+}
+
+func (r storageBlobsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"blobs": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"url": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"size": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"content_type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"access_tier": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"last_modified": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"metadata": {
+						Type:     pluginsdk.TypeMap,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r storageBlobsDataSource) ResourceType() string {
+	return "azurerm_storage_blobs"
+}
+
+func (r storageBlobsDataSource) ModelObject() interface{} {
+	return &storageBlobsDataSourceModel{}
+}
+
+func (r storageBlobsDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			var plan storageBlobsDataSourceModel
+			if err := metadata.Decode(&plan); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			id, err := parse.StorageContainerDataPlaneID(plan.StorageContainerId)
+			if err != nil {
+				return err
+			}
+
+			account, err := storageClient.FindAccount(ctx, id.AccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving Account %q for Container %q: %+v", id.AccountName, id.Name, err)
+			}
+			if account == nil {
+				return fmt.Errorf("unable to locate Storage Account %q", id.AccountName)
+			}
+
+			containersClient, err := storageClient.ContainersClient(ctx, *account)
+			if err != nil {
+				return fmt.Errorf("building Containers Client: %+v", err)
+			}
+
+			blobsClient, err := storageClient.BlobsClient(ctx, *account)
+			if err != nil {
+				return fmt.Errorf("building Blobs Client: %+v", err)
+			}
+
+			input := containers.ListBlobsInput{}
+			if plan.Prefix != "" {
+				input.Prefix = pointer.To(plan.Prefix)
+			}
+
+			items, err := containersClient.ListBlobs(ctx, account.ResourceGroup, id.AccountName, id.Name, input)
+			if err != nil {
+				return fmt.Errorf("listing Blobs within %s: %+v", id, err)
+			}
+
+			plan.Blobs = make([]blobModel, 0)
+			for _, item := range *items {
+				blob := blobModel{
+					Name:     item.Name,
+					Url:      blobsClient.GetResourceID(id.AccountName, id.Name, item.Name),
+					Metadata: map[string]string{},
+				}
+
+				if props := item.Properties; props != nil {
+					blob.Size = pointer.From(props.ContentLength)
+					blob.ContentType = pointer.From(props.ContentType)
+					blob.AccessTier = pointer.From(props.AccessTier)
+					blob.LastModified = pointer.From(props.LastModified)
+				}
+
+				// the metadata isn't returned when listing Blobs, so this has to be retrieved for each Blob
+				if plan.IncludeMetadata {
+					props, err := blobsClient.GetProperties(ctx, id.AccountName, id.Name, item.Name, blobs.GetPropertiesInput{})
+					if err != nil {
+						return fmt.Errorf("retrieving properties for Blob %q within %s: %+v", item.Name, id, err)
+					}
+					if props.MetaData != nil {
+						blob.Metadata = props.MetaData
+					}
+				}
+
+				plan.Blobs = append(plan.Blobs, blob)
+			}
+
+			if err := metadata.Encode(&plan); err != nil {
+				return fmt.Errorf("encoding %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
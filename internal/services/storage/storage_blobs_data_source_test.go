@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type storageBlobsDataSource struct{}
+
+func TestAccDataSourceStorageBlobs_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_blobs", "test")
+	d := storageBlobsDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: d.basic(data, "null", false),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("blobs.#").HasValue("2"),
+				check.That(data.ResourceName).Key("blobs.0.name").HasValue("logs/one.txt"),
+				check.That(data.ResourceName).Key("blobs.0.content_type").HasValue("text/plain"),
+				check.That(data.ResourceName).Key("blobs.0.size").HasValue("5"),
+				check.That(data.ResourceName).Key("blobs.0.access_tier").HasValue("Hot"),
+				check.That(data.ResourceName).Key("blobs.0.last_modified").Exists(),
+				check.That(data.ResourceName).Key("blobs.0.metadata.%").HasValue("0"),
+				check.That(data.ResourceName).Key("blobs.1.name").HasValue("other.txt"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceStorageBlobs_prefixWithMetadata(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_blobs", "test")
+	d := storageBlobsDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: d.basic(data, `"logs/"`, true),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("blobs.#").HasValue("1"),
+				check.That(data.ResourceName).Key("blobs.0.name").HasValue("logs/one.txt"),
+				check.That(data.ResourceName).Key("blobs.0.metadata.%").HasValue("1"),
+				check.That(data.ResourceName).Key("blobs.0.metadata.hello").HasValue("world"),
+			),
+		},
+	})
+}
+
+func (d storageBlobsDataSource) basic(data acceptance.TestData, prefix string, includeMetadata bool) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "test"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+
+resource "azurerm_storage_blob" "one" {
+  name                   = "logs/one.txt"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Block"
+  content_type           = "text/plain"
+  source_content         = "hello"
+
+  metadata = {
+    hello = "world"
+  }
+}
+
+resource "azurerm_storage_blob" "other" {
+  name                   = "other.txt"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Block"
+  source_content         = "world"
+}
+
+data "azurerm_storage_blobs" "test" {
+  storage_container_id = azurerm_storage_container.test.id
+  prefix               = %s
+  include_metadata     = %t
+
+  depends_on = [azurerm_storage_blob.one, azurerm_storage_blob.other]
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, prefix, includeMetadata)
+}
@@ -5,11 +5,14 @@ package storage
 
 import (
 	"fmt"
+	"log"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	intStor "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/client"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/shim"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
@@ -40,7 +43,58 @@ func dataSourceStorageContainer() *pluginsdk.Resource {
 
 			"metadata": MetaDataComputedSchema(),
 
-			// TODO: support for ACL's, Legal Holds and Immutability Policies
+			"default_encryption_scope": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"encryption_scope_override_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"acl": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"access_policy": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"start": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+									"expiry": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+									"permissions": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+									"expires_in_seconds": {
+										Type:     pluginsdk.TypeInt,
+										Computed: true,
+									},
+									"expired": {
+										Type:     pluginsdk.TypeBool,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			// TODO: support for Legal Holds and Immutability Policies
 			"has_immutability_policy": {
 				Type:     pluginsdk.TypeBool,
 				Computed: true,
@@ -99,6 +153,25 @@ func dataSourceStorageContainerRead(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("setting `metadata`: %+v", err)
 	}
 
+	d.Set("default_encryption_scope", props.DefaultEncryptionScope)
+	d.Set("encryption_scope_override_enabled", props.EncryptionScopeOverrideEnabled)
+	// the Stored Access Policies are only available from the Data Plane API
+	acls := make([]shim.StorageContainerSignedIdentifier, 0)
+	if storageClient.ContainersAuthenticationMethod() != intStor.AuthenticationMethodResourceManager {
+		result, err := client.GetAccessControl(ctx, account.ResourceGroup, accountName, containerName)
+		if err != nil {
+			return fmt.Errorf("retrieving ACL's for Container %q (Account %q / Resource Group %q): %s", containerName, accountName, account.ResourceGroup, err)
+		}
+		if result != nil {
+			acls = *result
+		}
+	} else {
+		log.Printf("[DEBUG] Skipping retrieving the ACL's for Container %q (Account %q) since the Data Plane API isn't used", containerName, accountName)
+	}
+	if err := d.Set("acl", flattenStorageContainerACLs(acls, time.Now())); err != nil {
+		return fmt.Errorf("setting `acl`: %+v", err)
+	}
+
 	d.Set("has_immutability_policy", props.HasImmutabilityPolicy)
 	d.Set("has_legal_hold", props.HasLegalHold)
 
@@ -107,3 +180,37 @@ func dataSourceStorageContainerRead(d *pluginsdk.ResourceData, meta interface{})
 
 	return nil
 }
+
+// flattenStorageContainerACLs flattens the ACL's, including how long remains (relative to `now`) until each Access
+// Policy expires - so that an Access Policy which is about to expire can be detected before SAS's using it stop working
+func flattenStorageContainerACLs(input []shim.StorageContainerSignedIdentifier, now time.Time) []interface{} {
+	result := make([]interface{}, 0)
+
+	for _, v := range input {
+		// an Access Policy without an expiry (or with one which can't be parsed) is left as not expiring
+		expiresInSeconds := 0
+		expired := false
+		if expiry, err := time.Parse(time.RFC3339, v.AccessPolicy.Expiry); err == nil {
+			remaining := expiry.Sub(now)
+			expiresInSeconds = int(remaining.Seconds())
+			expired = remaining <= 0
+		}
+
+		output := map[string]interface{}{
+			"id": v.Id,
+			"access_policy": []interface{}{
+				map[string]interface{}{
+					"start":              v.AccessPolicy.Start,
+					"expiry":             v.AccessPolicy.Expiry,
+					"permissions":        v.AccessPolicy.Permission,
+					"expires_in_seconds": expiresInSeconds,
+					"expired":            expired,
+				},
+			},
+		}
+
+		result = append(result, output)
+	}
+
+	return result
+}
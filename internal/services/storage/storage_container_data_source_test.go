@@ -20,8 +20,12 @@ func TestAccDataSourceStorageContainer_basic(t *testing.T) {
 		{
 			Config: StorageContainerDataSource{}.basic(data),
 			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("acl.#").HasValue("0"),
 				check.That(data.ResourceName).Key("container_access_type").HasValue("private"),
+				check.That(data.ResourceName).Key("default_encryption_scope").HasValue("$account-encryption-key"),
+				check.That(data.ResourceName).Key("encryption_scope_override_enabled").HasValue("true"),
 				check.That(data.ResourceName).Key("has_immutability_policy").HasValue("false"),
+				check.That(data.ResourceName).Key("has_legal_hold").HasValue("false"),
 				check.That(data.ResourceName).Key("metadata.%").HasValue("2"),
 				check.That(data.ResourceName).Key("metadata.k1").HasValue("v1"),
 				check.That(data.ResourceName).Key("metadata.k2").HasValue("v2"),
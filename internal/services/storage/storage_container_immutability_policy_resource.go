@@ -0,0 +1,359 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/blobcontainers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type StorageContainerImmutabilityPolicyResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = StorageContainerImmutabilityPolicyResource{}
+	_ sdk.ResourceWithCustomizeDiff = StorageContainerImmutabilityPolicyResource{}
+)
+
+type StorageContainerImmutabilityPolicyModel struct {
+	StorageContainerId                string `tfschema:"storage_container_id"`
+	ImmutabilityPeriodInDays          int64  `tfschema:"immutability_period_in_days"`
+	ProtectedAppendWritesAllEnabled   bool   `tfschema:"protected_append_writes_all_enabled"`
+	ProtectedAppendWritesEnabled      bool   `tfschema:"protected_append_writes_enabled"`
+	Locked                            bool   `tfschema:"locked"`
+	StorageContainerResourceManagerId string `tfschema:"storage_container_resource_manager_id"`
+}
+
+func (r StorageContainerImmutabilityPolicyResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_container_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StorageContainerDataPlaneID,
+		},
+
+		"immutability_period_in_days": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(1, 146000),
+		},
+
+		"protected_append_writes_all_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"protected_append_writes_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"locked": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+}
+
+func (r StorageContainerImmutabilityPolicyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_container_resource_manager_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r StorageContainerImmutabilityPolicyResource) ModelObject() interface{} {
+	return &StorageContainerImmutabilityPolicyModel{}
+}
+
+func (r StorageContainerImmutabilityPolicyResource) ResourceType() string {
+	return "azurerm_storage_container_immutability_policy"
+}
+
+func (r StorageContainerImmutabilityPolicyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.StorageContainerImmutabilityPolicyID
+}
+
+func (r StorageContainerImmutabilityPolicyResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			diff := metadata.ResourceDiff
+
+			// a locked Immutability Policy can never be unlocked, so the only way to "unlock" it is to recreate it
+			// which will fail during the deletion - making it clear this isn't possible
+			if oldLocked, newLocked := diff.GetChange("locked"); oldLocked.(bool) && !newLocked.(bool) {
+				if err := diff.ForceNew("locked"); err != nil {
+					return err
+				}
+			}
+
+			if oldLocked, _ := diff.GetChange("locked"); oldLocked.(bool) {
+				if oldPeriod, newPeriod := diff.GetChange("immutability_period_in_days"); newPeriod.(int) < oldPeriod.(int) {
+					return fmt.Errorf("`immutability_period_in_days` can only be increased once the Immutability Policy has been locked")
+				}
+				if diff.HasChange("protected_append_writes_all_enabled") {
+					return fmt.Errorf("`protected_append_writes_all_enabled` cannot be changed once the Immutability Policy has been locked")
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageContainerImmutabilityPolicyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.BlobContainers
+			storageClient := metadata.Client.Storage
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model StorageContainerImmutabilityPolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			containerId, err := parse.StorageContainerDataPlaneID(model.StorageContainerId)
+			if err != nil {
+				return err
+			}
+
+			account, err := storageClient.FindAccount(ctx, containerId.AccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving Account %q for Container %q: %+v", containerId.AccountName, containerId.Name, err)
+			}
+			if account == nil {
+				return fmt.Errorf("unable to locate Storage Account %q", containerId.AccountName)
+			}
+
+			id := parse.NewStorageContainerImmutabilityPolicyID(subscriptionId, account.ResourceGroup, containerId.AccountName, "default", containerId.Name, "default")
+			resourceManagerId := commonids.NewStorageContainerID(subscriptionId, account.ResourceGroup, containerId.AccountName, containerId.Name)
+
+			existing, err := client.GetImmutabilityPolicy(ctx, resourceManagerId, blobcontainers.DefaultGetImmutabilityPolicyOperationOptions())
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if immutabilityPolicyExists(existing.Model) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			input := blobcontainers.ImmutabilityPolicy{
+				Properties: blobcontainers.ImmutabilityPolicyProperty{
+					AllowProtectedAppendWrites:            pointer.To(model.ProtectedAppendWritesEnabled),
+					AllowProtectedAppendWritesAll:         pointer.To(model.ProtectedAppendWritesAllEnabled),
+					ImmutabilityPeriodSinceCreationInDays: pointer.To(model.ImmutabilityPeriodInDays),
+				},
+			}
+
+			resp, err := client.CreateOrUpdateImmutabilityPolicy(ctx, resourceManagerId, input, blobcontainers.DefaultCreateOrUpdateImmutabilityPolicyOperationOptions())
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			if model.Locked {
+				if resp.Model == nil || resp.Model.Etag == nil {
+					return fmt.Errorf("locking %s: `etag` was nil", id)
+				}
+
+				options := blobcontainers.LockImmutabilityPolicyOperationOptions{
+					IfMatch: resp.Model.Etag,
+				}
+				if _, err := client.LockImmutabilityPolicy(ctx, resourceManagerId, options); err != nil {
+					return fmt.Errorf("locking %s: %+v", id, err)
+				}
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r StorageContainerImmutabilityPolicyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.BlobContainers
+			storageClient := metadata.Client.Storage
+
+			id, err := parse.StorageContainerImmutabilityPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resourceManagerId := commonids.NewStorageContainerID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.ContainerName)
+
+			resp, err := client.GetImmutabilityPolicy(ctx, resourceManagerId, blobcontainers.DefaultGetImmutabilityPolicyOperationOptions())
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if !immutabilityPolicyExists(resp.Model) {
+				return metadata.MarkAsGone(id)
+			}
+
+			state := StorageContainerImmutabilityPolicyModel{
+				StorageContainerId:                parse.NewStorageContainerDataPlaneId(id.StorageAccountName, storageClient.Environment.StorageEndpointSuffix, id.ContainerName).ID(),
+				StorageContainerResourceManagerId: resourceManagerId.ID(),
+			}
+
+			props := resp.Model.Properties
+			state.ImmutabilityPeriodInDays = pointer.From(props.ImmutabilityPeriodSinceCreationInDays)
+			state.ProtectedAppendWritesEnabled = pointer.From(props.AllowProtectedAppendWrites)
+			state.ProtectedAppendWritesAllEnabled = pointer.From(props.AllowProtectedAppendWritesAll)
+			state.Locked = pointer.From(props.State) == blobcontainers.ImmutabilityPolicyStateLocked
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageContainerImmutabilityPolicyResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.BlobContainers
+
+			id, err := parse.StorageContainerImmutabilityPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model StorageContainerImmutabilityPolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resourceManagerId := commonids.NewStorageContainerID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.ContainerName)
+
+			existing, err := client.GetImmutabilityPolicy(ctx, resourceManagerId, blobcontainers.DefaultGetImmutabilityPolicyOperationOptions())
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if existing.Model == nil || existing.Model.Etag == nil {
+				return fmt.Errorf("retrieving %s: `etag` was nil", id)
+			}
+			etag := existing.Model.Etag
+			alreadyLocked := pointer.From(existing.Model.Properties.State) == blobcontainers.ImmutabilityPolicyStateLocked
+
+			input := blobcontainers.ImmutabilityPolicy{
+				Properties: blobcontainers.ImmutabilityPolicyProperty{
+					AllowProtectedAppendWrites:            pointer.To(model.ProtectedAppendWritesEnabled),
+					AllowProtectedAppendWritesAll:         pointer.To(model.ProtectedAppendWritesAllEnabled),
+					ImmutabilityPeriodSinceCreationInDays: pointer.To(model.ImmutabilityPeriodInDays),
+				},
+			}
+
+			if alreadyLocked {
+				// once locked the Immutability Policy can only be extended
+				if metadata.ResourceData.HasChanges("immutability_period_in_days", "protected_append_writes_enabled") {
+					options := blobcontainers.ExtendImmutabilityPolicyOperationOptions{
+						IfMatch: etag,
+					}
+					if _, err := client.ExtendImmutabilityPolicy(ctx, resourceManagerId, input, options); err != nil {
+						return fmt.Errorf("extending %s: %+v", id, err)
+					}
+				}
+
+				return nil
+			}
+
+			if metadata.ResourceData.HasChanges("immutability_period_in_days", "protected_append_writes_enabled", "protected_append_writes_all_enabled") {
+				options := blobcontainers.CreateOrUpdateImmutabilityPolicyOperationOptions{
+					IfMatch: etag,
+				}
+				resp, err := client.CreateOrUpdateImmutabilityPolicy(ctx, resourceManagerId, input, options)
+				if err != nil {
+					return fmt.Errorf("updating %s: %+v", id, err)
+				}
+				if resp.Model != nil && resp.Model.Etag != nil {
+					etag = resp.Model.Etag
+				}
+			}
+
+			if model.Locked {
+				options := blobcontainers.LockImmutabilityPolicyOperationOptions{
+					IfMatch: etag,
+				}
+				if _, err := client.LockImmutabilityPolicy(ctx, resourceManagerId, options); err != nil {
+					return fmt.Errorf("locking %s: %+v", id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageContainerImmutabilityPolicyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.BlobContainers
+
+			id, err := parse.StorageContainerImmutabilityPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resourceManagerId := commonids.NewStorageContainerID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.ContainerName)
+
+			existing, err := client.GetImmutabilityPolicy(ctx, resourceManagerId, blobcontainers.DefaultGetImmutabilityPolicyOperationOptions())
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return nil
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if existing.Model == nil || existing.Model.Etag == nil {
+				return fmt.Errorf("retrieving %s: `etag` was nil", id)
+			}
+
+			if pointer.From(existing.Model.Properties.State) == blobcontainers.ImmutabilityPolicyStateLocked {
+				return fmt.Errorf("deleting %s: a locked Immutability Policy cannot be deleted", id)
+			}
+
+			options := blobcontainers.DeleteImmutabilityPolicyOperationOptions{
+				IfMatch: existing.Model.Etag,
+			}
+			if _, err := client.DeleteImmutabilityPolicy(ctx, resourceManagerId, options); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// immutabilityPolicyExists returns whether an Immutability Policy has been configured, since the API returns
+// an empty Immutability Policy (rather than a 404) for a Container without one
+func immutabilityPolicyExists(input *blobcontainers.ImmutabilityPolicy) bool {
+	if input == nil || input.Etag == nil || *input.Etag == "" {
+		return false
+	}
+
+	return pointer.From(input.Properties.ImmutabilityPeriodSinceCreationInDays) > 0
+}
@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/shim"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// storageContainerInventoryParallelism is the number of Containers retrieved concurrently, the number of requests
+// made against the Storage Account is also limited by `storage_max_concurrent_data_plane_operations`
+const storageContainerInventoryParallelism = 10
+
+type storageContainerInventoryDataSource struct{}
+
+var _ sdk.DataSource = storageContainerInventoryDataSource{}
+
+type storageContainerInventoryDataSourceModel struct {
+	StorageAccountId string                           `tfschema:"storage_account_id"`
+	Names            []string                         `tfschema:"names"`
+	Containers       []storageContainerInventoryModel `tfschema:"containers"`
+}
+
+type storageContainerInventoryModel struct {
+	Name                string `tfschema:"name"`
+	Exists              bool   `tfschema:"exists"`
+	ContainerAccessType string `tfschema:"container_access_type"`
+}
+
+func (r storageContainerInventoryDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: commonids.ValidateStorageAccountID,
+		},
+
+		"names": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validate.StorageContainerLegacyName,
+			},
+		},
+	}
+}
+
+func (r storageContainerInventoryDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"containers": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"exists": {
+						Type:     pluginsdk.TypeBool,
+						Computed: true,
+					},
+					"container_access_type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r storageContainerInventoryDataSource) ResourceType() string {
+	return "azurerm_storage_container_inventory"
+}
+
+func (r storageContainerInventoryDataSource) ModelObject() interface{} {
+	return &storageContainerInventoryDataSourceModel{}
+}
+
+func (r storageContainerInventoryDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			var plan storageContainerInventoryDataSourceModel
+			if err := metadata.Decode(&plan); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			id, err := commonids.ParseStorageAccountID(plan.StorageAccountId)
+			if err != nil {
+				return err
+			}
+
+			account, err := storageClient.FindAccount(ctx, id.StorageAccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if account == nil {
+				return fmt.Errorf("%s was not found", id)
+			}
+
+			containersClient, err := storageClient.ContainersClient(ctx, *account)
+			if err != nil {
+				return fmt.Errorf("building Containers Client: %+v", err)
+			}
+
+			containers, err := getStorageContainerInventory(ctx, containersClient, account.ResourceGroup, id.StorageAccountName, plan.Names)
+			if err != nil {
+				return fmt.Errorf("retrieving Containers within %s: %+v", id, err)
+			}
+			plan.Containers = containers
+
+			if err := metadata.Encode(&plan); err != nil {
+				return fmt.Errorf("encoding %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+// getStorageContainerInventory retrieves the specified Containers concurrently, returning these in the same order as
+// the specified names - where a Container doesn't exist this is returned with `Exists` set to false
+func getStorageContainerInventory(ctx context.Context, client shim.StorageContainerWrapper, resourceGroup, accountName string, names []string) ([]storageContainerInventoryModel, error) {
+	results := make([]storageContainerInventoryModel, len(names))
+	errors := make([]error, len(names))
+
+	indexes := make(chan int)
+	wg := sync.WaitGroup{}
+	for i := 0; i < storageContainerInventoryParallelism && i < len(names); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range indexes {
+				name := names[index]
+				props, err := client.Get(ctx, resourceGroup, accountName, name)
+				if err != nil {
+					errors[index] = fmt.Errorf("retrieving Container %q: %+v", name, err)
+					continue
+				}
+
+				result := storageContainerInventoryModel{
+					Name: name,
+				}
+				if props != nil {
+					result.Exists = true
+					result.ContainerAccessType = flattenStorageContainerAccessLevel(props.AccessLevel)
+				}
+				results[index] = result
+			}
+		}()
+	}
+
+	for i := range names {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	for _, err := range errors {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return results, nil
+}
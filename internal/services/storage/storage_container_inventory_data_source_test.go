@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type storageContainerInventoryDataSource struct{}
+
+func TestAccDataSourceStorageContainerInventory_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_container_inventory", "test")
+	d := storageContainerInventoryDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: d.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("containers.#").HasValue("3"),
+				check.That(data.ResourceName).Key("containers.0.name").HasValue("private"),
+				check.That(data.ResourceName).Key("containers.0.exists").HasValue("true"),
+				check.That(data.ResourceName).Key("containers.0.container_access_type").HasValue("private"),
+				check.That(data.ResourceName).Key("containers.1.name").HasValue("missing"),
+				check.That(data.ResourceName).Key("containers.1.exists").HasValue("false"),
+				check.That(data.ResourceName).Key("containers.1.container_access_type").HasValue(""),
+				check.That(data.ResourceName).Key("containers.2.name").HasValue("public"),
+				check.That(data.ResourceName).Key("containers.2.exists").HasValue("true"),
+				check.That(data.ResourceName).Key("containers.2.container_access_type").HasValue("blob"),
+			),
+		},
+	})
+}
+
+func (d storageContainerInventoryDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                            = "acctestacc%s"
+  resource_group_name             = azurerm_resource_group.test.name
+  location                        = azurerm_resource_group.test.location
+  account_tier                    = "Standard"
+  account_replication_type        = "LRS"
+  allow_nested_items_to_be_public = true
+}
+
+resource "azurerm_storage_container" "private" {
+  name                  = "private"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+
+resource "azurerm_storage_container" "public" {
+  name                  = "public"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "blob"
+}
+
+data "azurerm_storage_container_inventory" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  names              = ["private", "missing", "public"]
+
+  depends_on = [
+    azurerm_storage_container.private,
+    azurerm_storage_container.public,
+  ]
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
@@ -4,15 +4,20 @@
 package storage
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/blobcontainers"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	intStor "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/client"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/shim"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -20,6 +25,9 @@ import (
 	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/blob/containers"
 )
 
+// storageContainerWebName is the name of the Container which the Static Website for a Storage Account is served from
+const storageContainerWebName = "$web"
+
 func resourceStorageContainer() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceStorageContainerCreate,
@@ -32,9 +40,12 @@ func resourceStorageContainer() *pluginsdk.Resource {
 			return err
 		}),
 
-		SchemaVersion: 1,
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceStorageContainerCustomizeDiff),
+
+		SchemaVersion: 2,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
 			0: migration.ContainerV0ToV1{},
+			1: migration.ContainerV1ToV2{},
 		}),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
@@ -45,11 +56,13 @@ func resourceStorageContainer() *pluginsdk.Resource {
 		},
 
 		Schema: map[string]*pluginsdk.Schema{
+			// the current naming rules are enforced for new Containers in the CustomizeDiff, since existing
+			// Containers may have been created prior to these (see `storage_allow_legacy_container_names`)
 			"name": {
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validate.StorageContainerName,
+				ValidateFunc: validate.StorageContainerLegacyName,
 			},
 
 			"storage_account_name": {
@@ -72,6 +85,29 @@ func resourceStorageContainer() *pluginsdk.Resource {
 
 			"metadata": MetaDataComputedSchema(),
 
+			// the Encryption Scope can only be specified when the Container is created, the Storage service
+			// doesn't support changing either of these for an existing Container
+			"default_encryption_scope": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageEncryptionScopeName,
+			},
+
+			"encryption_scope_override_enabled": {
+				Type:         pluginsdk.TypeBool,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      true,
+				RequiredWith: []string{"default_encryption_scope"},
+			},
+
+			"authentication_method": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			// TODO: support for ACL's, Legal Holds and Immutability Policies
 			"has_immutability_policy": {
 				Type:     pluginsdk.TypeBool,
@@ -83,14 +119,104 @@ func resourceStorageContainer() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"is_web_container": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"immutability_policy": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"period_in_days": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"locked": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"allow_protected_append_writes": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"lease_duration": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"lease_state": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"lease_status": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"public_access_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
 			"resource_manager_id": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
 			},
+
+			"static_website": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"index_document": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"error_404_document": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+func resourceStorageContainerCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, meta interface{}) error {
+	name := diff.Get("name").(string)
+	if name == "" {
+		// the name isn't known yet
+		return nil
+	}
+
+	// existing Containers created prior to the current naming rules can continue to be managed when opted-in, however
+	// a new Container (including one being recreated with a different name) must always use a valid name
+	isNew := diff.Id() == "" || diff.HasChange("name")
+	if !isNew && meta.(*clients.Client).Storage.AllowLegacyContainerNames() {
+		return nil
+	}
+
+	if _, errs := validate.StorageContainerName(name, "name"); len(errs) > 0 {
+		if !isNew {
+			return fmt.Errorf("%+v - set `storage_allow_legacy_container_names` in the Provider block to manage an existing Container which was created prior to the current naming rules", errs[0])
+		}
+		return errs[0]
+	}
+
+	return nil
+}
+
 func resourceStorageContainerCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	storageClient := meta.(*clients.Client).Storage
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
@@ -102,7 +228,7 @@ func resourceStorageContainerCreate(d *pluginsdk.ResourceData, meta interface{})
 	accessLevel := expandStorageContainerAccessLevel(accessLevelRaw)
 
 	metaDataRaw := d.Get("metadata").(map[string]interface{})
-	metaData := ExpandMetaData(metaDataRaw)
+	metaData := storageClient.WithDefaultContainerMetaData(ExpandMetaData(metaDataRaw))
 
 	account, err := storageClient.FindAccount(ctx, accountName)
 	if err != nil {
@@ -117,8 +243,18 @@ func resourceStorageContainerCreate(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("building storage client: %+v", err)
 	}
 
-	id := parse.NewStorageContainerDataPlaneId(accountName, storageClient.Environment.StorageEndpointSuffix, containerName).ID()
-	exists, err := client.Exists(ctx, account.ResourceGroup, accountName, containerName)
+	// the ID is built from the Storage Account's endpoint so that it includes the DNS Zone (where one is used), as
+	// such it matches the ID which is parsed when the same resource is imported
+	host, err := account.DataPlaneHost(intStor.EndpointTypeBlob)
+	if err != nil {
+		return err
+	}
+	dataPlaneId := parse.NewStorageContainerDataPlaneId(accountName, host.DomainSuffix, containerName)
+	dataPlaneId.DnsZone = host.DnsZone
+	id := dataPlaneId.ID()
+	exists, err := checkExistsWithTimeout(ctx, func(ctx context.Context) (*bool, error) {
+		return client.Exists(ctx, account.ResourceGroup, accountName, containerName)
+	})
 	if err != nil {
 		return err
 	}
@@ -126,16 +262,26 @@ func resourceStorageContainerCreate(d *pluginsdk.ResourceData, meta interface{})
 		return tf.ImportAsExistsError("azurerm_storage_container", id)
 	}
 
+	if account.IsHnsEnabled() {
+		logStorageContainerHierarchicalNamespaceWarning(accountName, containerName)
+	}
+
 	log.Printf("[INFO] Creating Container %q in Storage Account %q", containerName, accountName)
-	input := containers.CreateInput{
-		AccessLevel: accessLevel,
-		MetaData:    metaData,
+	input := shim.StorageContainerCreateInput{
+		CreateInput: containers.CreateInput{
+			AccessLevel: accessLevel,
+			MetaData:    metaData,
+		},
+		DefaultEncryptionScope:         d.Get("default_encryption_scope").(string),
+		EncryptionScopeOverrideEnabled: d.Get("encryption_scope_override_enabled").(bool),
 	}
 
 	if err := client.Create(ctx, account.ResourceGroup, accountName, containerName, input); err != nil {
 		return fmt.Errorf("failed creating container: %+v", err)
 	}
 
+	// the ID is set as soon as the Container exists, such that any drift in the MetaData (or a failure when
+	// reading the Container) is reconciled by an Update during the next apply, rather than recreating it
 	d.SetId(id)
 	return resourceStorageContainerRead(d, meta)
 }
@@ -162,28 +308,52 @@ func resourceStorageContainerUpdate(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("building Containers Client for Storage Account %q (Resource Group %q): %s", id.AccountName, account.ResourceGroup, err)
 	}
 
+	// updating the Access Level or the MetaData via the Data Plane fails (with a 404) when the Storage Account has
+	// Shared Key access disabled (and so only Azure AD authentication is available), as such these are updated via
+	// the Resource Manager API in that case - and otherwise continue to use the Data Plane API
+	updateClient := client
+	if account.SharedKeyAccessDisabled() {
+		accountId, err := commonids.ParseStorageAccountID(account.ID)
+		if err != nil {
+			return err
+		}
+		log.Printf("[DEBUG] Shared Key access is disabled for Storage Account %q - updating Container %q using the Resource Manager API", id.AccountName, id.Name)
+		updateClient = shim.NewResourceManagerStorageContainerWrapper(storageClient.ResourceManager.BlobContainers, accountId.SubscriptionId)
+	}
+
 	if d.HasChange("container_access_type") {
 		log.Printf("[DEBUG] Updating the Access Control for Container %q (Storage Account %q / Resource Group %q)..", id.Name, id.AccountName, account.ResourceGroup)
 		accessLevelRaw := d.Get("container_access_type").(string)
 		accessLevel := expandStorageContainerAccessLevel(accessLevelRaw)
 
-		if err := client.UpdateAccessLevel(ctx, account.ResourceGroup, id.AccountName, id.Name, accessLevel); err != nil {
+		if err := updateClient.UpdateAccessLevel(ctx, account.ResourceGroup, id.AccountName, id.Name, accessLevel); err != nil {
 			return fmt.Errorf("updating the Access Control for Container %q (Storage Account %q / Resource Group %q): %s", id.Name, id.AccountName, account.ResourceGroup, err)
 		}
 
 		log.Printf("[DEBUG] Updated the Access Control for Container %q (Storage Account %q / Resource Group %q)", id.Name, id.AccountName, account.ResourceGroup)
 	}
 
-	if d.HasChange("metadata") {
-		log.Printf("[DEBUG] Updating the MetaData for Container %q (Storage Account %q / Resource Group %q)..", id.Name, id.AccountName, account.ResourceGroup)
-		metaDataRaw := d.Get("metadata").(map[string]interface{})
-		metaData := ExpandMetaData(metaDataRaw)
+	if account.IsHnsEnabled() && d.HasChanges("container_access_type", "metadata") {
+		logStorageContainerHierarchicalNamespaceWarning(id.AccountName, id.Name)
+	}
 
-		if err := client.UpdateMetaData(ctx, account.ResourceGroup, id.AccountName, id.Name, metaData); err != nil {
-			return fmt.Errorf("updating the MetaData for Container %q (Storage Account %q / Resource Group %q): %s", id.Name, id.AccountName, account.ResourceGroup, err)
+	if d.HasChange("metadata") {
+		// the MetaData is replaced as a whole, so this is only written when the expanded MetaData actually differs -
+		// since `HasChange` can report a change to the map where the keys and values are unchanged
+		oldMetaDataRaw, newMetaDataRaw := d.GetChange("metadata")
+		oldMetaData := storageClient.WithDefaultContainerMetaData(ExpandMetaData(oldMetaDataRaw.(map[string]interface{})))
+		metaData := storageClient.WithDefaultContainerMetaData(ExpandMetaData(newMetaDataRaw.(map[string]interface{})))
+
+		if metaDataEqual(oldMetaData, metaData) {
+			log.Printf("[DEBUG] Skipping updating the MetaData for Container %q (Storage Account %q) since this is unchanged", id.Name, id.AccountName)
+		} else {
+			log.Printf("[DEBUG] Updating the MetaData for Container %q (Storage Account %q / Resource Group %q)..", id.Name, id.AccountName, account.ResourceGroup)
+			if err := updateClient.UpdateMetaData(ctx, account.ResourceGroup, id.AccountName, id.Name, metaData); err != nil {
+				return fmt.Errorf("updating the MetaData for Container %q (Storage Account %q / Resource Group %q): %s", id.Name, id.AccountName, account.ResourceGroup, err)
+			}
+
+			log.Printf("[DEBUG] Updated the MetaData for Container %q (Storage Account %q / Resource Group %q)", id.Name, id.AccountName, account.ResourceGroup)
 		}
-
-		log.Printf("[DEBUG] Updated the MetaData for Container %q (Storage Account %q / Resource Group %q)", id.Name, id.AccountName, account.ResourceGroup)
 	}
 
 	return resourceStorageContainerRead(d, meta)
@@ -191,7 +361,6 @@ func resourceStorageContainerUpdate(d *pluginsdk.ResourceData, meta interface{})
 
 func resourceStorageContainerRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	storageClient := meta.(*clients.Client).Storage
-	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -209,6 +378,10 @@ func resourceStorageContainerRead(d *pluginsdk.ResourceData, meta interface{}) e
 		d.SetId("")
 		return nil
 	}
+	accountId, err := commonids.ParseStorageAccountID(account.ID)
+	if err != nil {
+		return err
+	}
 	client, err := storageClient.ContainersClient(ctx, *account)
 	if err != nil {
 		return fmt.Errorf("building Containers Client for Storage Account %q (Resource Group %q): %s", id.AccountName, account.ResourceGroup, err)
@@ -224,21 +397,89 @@ func resourceStorageContainerRead(d *pluginsdk.ResourceData, meta interface{}) e
 		return nil
 	}
 
+	// the host within the ID differs from that of the Storage Account's endpoint when the state has been moved between
+	// clouds, or when the ID was built without the DNS Zone used by the Storage Account - since the Container exists the ID
+	// is updated, rather than the Container needing to be recreated
+	host, err := account.DataPlaneHost(intStor.EndpointTypeBlob)
+	if err != nil {
+		return err
+	}
+	if id.DomainSuffix != host.DomainSuffix || id.DnsZone != host.DnsZone {
+		newId := *id
+		newId.DomainSuffix = host.DomainSuffix
+		newId.DnsZone = host.DnsZone
+		log.Printf("[DEBUG] Updating the ID for %s to use the Domain Suffix %q and DNS Zone %q", id, host.DomainSuffix, host.DnsZone)
+		d.SetId(newId.ID())
+	}
+
 	d.Set("name", id.Name)
 	d.Set("storage_account_name", id.AccountName)
 
 	d.Set("container_access_type", flattenStorageContainerAccessLevel(props.AccessLevel))
+	d.Set("public_access_enabled", props.AccessLevel != containers.Private)
 
-	if err := d.Set("metadata", FlattenMetaData(props.MetaData)); err != nil {
+	// the `storage_default_container_metadata` assigned from the Provider block isn't tracked in the state
+	metaData := storageClient.WithoutDefaultContainerMetaData(props.MetaData, ExpandMetaData(d.Get("metadata").(map[string]interface{})))
+	if err := d.Set("metadata", FlattenMetaData(metaData)); err != nil {
 		return fmt.Errorf("setting `metadata`: %+v", err)
 	}
 
+	d.Set("authentication_method", storageClient.ContainersAuthenticationMethod())
+	d.Set("default_encryption_scope", props.DefaultEncryptionScope)
+	d.Set("encryption_scope_override_enabled", props.EncryptionScopeOverrideEnabled)
 	d.Set("has_immutability_policy", props.HasImmutabilityPolicy)
 	d.Set("has_legal_hold", props.HasLegalHold)
 
-	resourceManagerId := commonids.NewStorageContainerID(subscriptionId, account.ResourceGroup, id.AccountName, id.Name)
+	// the details of the Immutability Policy are only available from the Resource Manager API, so these are only
+	// retrieved when the Container has an Immutability Policy
+	immutabilityPolicy := make([]interface{}, 0)
+	if props.HasImmutabilityPolicy {
+		containerId := commonids.NewStorageContainerID(accountId.SubscriptionId, account.ResourceGroup, id.AccountName, id.Name)
+		resp, err := storageClient.ResourceManager.BlobContainers.Get(ctx, containerId)
+		if err != nil {
+			return fmt.Errorf("retrieving the Immutability Policy for %s: %+v", containerId, err)
+		}
+		if model := resp.Model; model != nil && model.Properties != nil {
+			immutabilityPolicy = flattenStorageContainerImmutabilityPolicy(model.Properties.ImmutabilityPolicy)
+		}
+	}
+	if err := d.Set("immutability_policy", immutabilityPolicy); err != nil {
+		return fmt.Errorf("setting `immutability_policy`: %+v", err)
+	}
+
+	leaseDuration := ""
+	if props.LeaseDuration != nil {
+		leaseDuration = string(*props.LeaseDuration)
+	}
+	d.Set("lease_duration", leaseDuration)
+	d.Set("lease_state", string(props.LeaseState))
+	d.Set("lease_status", string(props.LeaseStatus))
+
+	resourceManagerId := commonids.NewStorageContainerID(accountId.SubscriptionId, account.ResourceGroup, id.AccountName, id.Name)
 	d.Set("resource_manager_id", resourceManagerId.ID())
 
+	// the `$web` Container serves the Static Website for the Storage Account, the settings for which are part of the
+	// Blob Service Properties - so these are only retrieved for this Container
+	isWebContainer := id.Name == storageContainerWebName
+	staticWebsite := make([]interface{}, 0)
+	if isWebContainer {
+		website, err := storageClient.StaticWebsite(ctx, *account)
+		if err != nil {
+			return fmt.Errorf("retrieving the Static Website settings for Storage Account %q (Resource Group %q): %s", id.AccountName, account.ResourceGroup, err)
+		}
+
+		if website != nil {
+			staticWebsite = append(staticWebsite, map[string]interface{}{
+				"index_document":     website.IndexDocument,
+				"error_404_document": website.ErrorDocument404Path,
+			})
+		}
+	}
+	d.Set("is_web_container", isWebContainer)
+	if err := d.Set("static_website", staticWebsite); err != nil {
+		return fmt.Errorf("setting `static_website`: %+v", err)
+	}
+
 	return nil
 }
 
@@ -268,13 +509,48 @@ func resourceStorageContainerDelete(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("deleting Container %q (Storage Account %q / Resource Group %q): %s", id.Name, id.AccountName, account.ResourceGroup, err)
 	}
 
+	// the Container can remain for a short while after the delete has been accepted, during which time creating a
+	// Container with the same name returns a 409 - so we wait for it to be gone, so that it can be recreated
+	log.Printf("[DEBUG] Waiting for Container %q (Storage Account %q / Resource Group %q) to be deleted", id.Name, id.AccountName, account.ResourceGroup)
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context had no deadline")
+	}
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:    []string{"Exists"},
+		Target:     []string{"Deleted"},
+		Refresh:    storageContainerDeletedRefreshFunc(ctx, client, account.ResourceGroup, id.AccountName, id.Name),
+		MinTimeout: 5 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for Container %q (Storage Account %q / Resource Group %q) to be deleted: %s", id.Name, id.AccountName, account.ResourceGroup, err)
+	}
+
 	return nil
 }
 
+func storageContainerDeletedRefreshFunc(ctx context.Context, client shim.StorageContainerWrapper, resourceGroup, accountName, containerName string) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		exists, err := client.Exists(ctx, resourceGroup, accountName, containerName)
+		if err != nil {
+			return nil, "", fmt.Errorf("checking for presence of Container %q (Storage Account %q / Resource Group %q): %s", containerName, accountName, resourceGroup, err)
+		}
+		if exists != nil && *exists {
+			return containerName, "Exists", nil
+		}
+
+		return containerName, "Deleted", nil
+	}
+}
+
 func expandStorageContainerAccessLevel(input string) containers.AccessLevel {
 	// for historical reasons, "private" above is an empty string in the API
 	// so the enum doesn't 1:1 match. You could argue the SDK should handle this
-	// but this is suitable for now
+	// but this is suitable for now.
+	//
+	// NOTE: the API doesn't distinguish between the `x-ms-blob-public-access` header being omitted and the
+	// Container being private - a Container without this header is private, so these are treated identically
 	if input == "private" {
 		return containers.Private
 	}
@@ -290,3 +566,25 @@ func flattenStorageContainerAccessLevel(input containers.AccessLevel) string {
 
 	return string(input)
 }
+
+func flattenStorageContainerImmutabilityPolicy(input *blobcontainers.ImmutabilityPolicyProperties) []interface{} {
+	if input == nil || input.Properties == nil {
+		return []interface{}{}
+	}
+
+	props := input.Properties
+	return []interface{}{
+		map[string]interface{}{
+			"period_in_days":                int(pointer.From(props.ImmutabilityPeriodSinceCreationInDays)),
+			"locked":                        pointer.From(props.State) == blobcontainers.ImmutabilityPolicyStateLocked,
+			"allow_protected_append_writes": pointer.From(props.AllowProtectedAppendWrites),
+		},
+	}
+}
+
+// logStorageContainerHierarchicalNamespaceWarning notes that, when the Hierarchical Namespace is enabled for the
+// Storage Account, the Container is also a Data Lake Gen2 File System - whose POSIX ACL's (and Owner/Group) can
+// only be managed via the Data Lake API, and so aren't managed by this resource
+func logStorageContainerHierarchicalNamespaceWarning(accountName, containerName string) {
+	log.Printf("[WARN] Storage Account %q has the Hierarchical Namespace enabled, as such Container %q is also a Data Lake Gen2 File System. Only the `container_access_type` and `metadata` are managed by this resource - the POSIX ACL's (and Owner/Group) for the File System aren't, use the `azurerm_storage_data_lake_gen2_filesystem` resource to manage these instead.", accountName, containerName)
+}
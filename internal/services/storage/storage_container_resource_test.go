@@ -6,9 +6,11 @@ package storage_test
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -29,6 +31,10 @@ func TestAccStorageContainer_basic(t *testing.T) {
 			Config: r.basic(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("lease_state").HasValue("available"),
+				check.That(data.ResourceName).Key("lease_status").HasValue("unlocked"),
+				check.That(data.ResourceName).Key("authentication_method").HasValue("shared_key"),
+				check.That(data.ResourceName).Key("is_web_container").HasValue("false"),
 			),
 		},
 		data.ImportStep(),
@@ -69,6 +75,7 @@ func TestAccStorageContainer_basicAzureADAuth(t *testing.T) {
 			Config: r.basicAzureADAuth(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("authentication_method").HasValue("aad"),
 			),
 		},
 		data.ImportStep(),
@@ -100,6 +107,7 @@ func TestAccStorageContainer_update(t *testing.T) {
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
 				check.That(data.ResourceName).Key("container_access_type").HasValue("private"),
+				check.That(data.ResourceName).Key("public_access_enabled").HasValue("false"),
 			),
 		},
 		{
@@ -107,6 +115,7 @@ func TestAccStorageContainer_update(t *testing.T) {
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
 				check.That(data.ResourceName).Key("container_access_type").HasValue("container"),
+				check.That(data.ResourceName).Key("public_access_enabled").HasValue("true"),
 			),
 		},
 		data.ImportStep(),
@@ -142,6 +151,142 @@ func TestAccStorageContainer_metaData(t *testing.T) {
 	})
 }
 
+func TestAccStorageContainer_metaDataConvergesAfterDrift(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_container", "test")
+	r := StorageContainerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			// the Container is created successfully, but the MetaData subsequently doesn't match the configuration
+			Config: r.metaData(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.updateMetaDataOutOfBand(map[string]string{
+					"hello": "mismatch",
+				})),
+			),
+			ExpectNonEmptyPlan: true,
+		},
+		{
+			Config: r.metaData(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("metadata.%").HasValue("1"),
+				check.That(data.ResourceName).Key("metadata.hello").HasValue("world"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageContainer_defaultMetaData(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_container", "test")
+	r := StorageContainerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.defaultMetaData(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("metadata.%").HasValue("1"),
+				check.That(data.ResourceName).Key("metadata.hello").HasValue("world"),
+				data.CheckWithClient(r.hasMetaDataInAzure(map[string]string{
+					"hello": "world",
+					"owner": "platform",
+				})),
+			),
+		},
+		{
+			// the value specified on the Container takes precedence over the Provider default
+			Config: r.defaultMetaData(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("metadata.%").HasValue("2"),
+				check.That(data.ResourceName).Key("metadata.owner").HasValue("data"),
+				data.CheckWithClient(r.hasMetaDataInAzure(map[string]string{
+					"hello": "world",
+					"owner": "data",
+				})),
+			),
+		},
+	})
+}
+
+func TestAccStorageContainer_resourceManager(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_container", "test")
+	r := StorageContainerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.resourceManager(data, "private", "world"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("authentication_method").HasValue("resource_manager"),
+				check.That(data.ResourceName).Key("container_access_type").HasValue("private"),
+				check.That(data.ResourceName).Key("metadata.hello").HasValue("world"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.resourceManager(data, "container", "there"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("container_access_type").HasValue("container"),
+				check.That(data.ResourceName).Key("public_access_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("metadata.hello").HasValue("there"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageContainer_metaDataSharedKeyDisabled(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_container", "test")
+	r := StorageContainerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.metaDataSharedKeyDisabled(data, "hello", "world"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.metaDataSharedKeyDisabled(data, "panda", "pops"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("metadata.panda").HasValue("pops"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageContainer_accessTypeSharedKeyDisabled(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_container", "test")
+	r := StorageContainerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.accessTypeSharedKeyDisabled(data, "private"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("container_access_type").HasValue("private"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.accessTypeSharedKeyDisabled(data, "blob"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("container_access_type").HasValue("blob"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccStorageContainer_disappears(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_storage_container", "test")
 	r := StorageContainerResource{}
@@ -180,6 +325,64 @@ func TestAccStorageContainer_web(t *testing.T) {
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
 				check.That(data.ResourceName).Key("name").HasValue("$web"),
+				check.That(data.ResourceName).Key("is_web_container").HasValue("true"),
+				check.That(data.ResourceName).Key("static_website.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageContainer_webStaticWebsite(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_container", "test")
+	r := StorageContainerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.web(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.webStaticWebsite(data),
+		},
+		{
+			// the Static Website is enabled after the Container has been read, so this is checked once refreshed
+			Config: r.webStaticWebsite(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("is_web_container").HasValue("true"),
+				check.That(data.ResourceName).Key("static_website.#").HasValue("1"),
+				check.That(data.ResourceName).Key("static_website.0.index_document").HasValue("index.html"),
+				check.That(data.ResourceName).Key("static_website.0.error_404_document").HasValue("404.html"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageContainer_encryptionScope(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_container", "test")
+	r := StorageContainerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.encryptionScope(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("default_encryption_scope").HasValue(fmt.Sprintf("acctestES%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("encryption_scope_override_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			// this can't be changed for an existing Container, so is recreated
+			Config: r.encryptionScope(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("encryption_scope_override_enabled").HasValue("false"),
 			),
 		},
 		data.ImportStep(),
@@ -232,6 +435,61 @@ func (r StorageContainerResource) Destroy(ctx context.Context, client *clients.C
 	return utils.Bool(true), nil
 }
 
+func (r StorageContainerResource) updateMetaDataOutOfBand(metaData map[string]string) acceptance.ClientCheckFunc {
+	return func(ctx context.Context, client *clients.Client, state *terraform.InstanceState) error {
+		id, err := parse.StorageContainerDataPlaneID(state.ID)
+		if err != nil {
+			return err
+		}
+		account, err := client.Storage.FindAccount(ctx, id.AccountName)
+		if err != nil {
+			return fmt.Errorf("retrieving Account %q for Container %q: %+v", id.AccountName, id.Name, err)
+		}
+		if account == nil {
+			return fmt.Errorf("unable to locate Storage Account %q", id.AccountName)
+		}
+		containersClient, err := client.Storage.ContainersClient(ctx, *account)
+		if err != nil {
+			return fmt.Errorf("building Containers Client: %+v", err)
+		}
+		if err := containersClient.UpdateMetaData(ctx, account.ResourceGroup, id.AccountName, id.Name, metaData); err != nil {
+			return fmt.Errorf("updating the MetaData for Container %q (Account %q / Resource Group %q): %+v", id.Name, id.AccountName, account.ResourceGroup, err)
+		}
+		return nil
+	}
+}
+
+func (r StorageContainerResource) hasMetaDataInAzure(expected map[string]string) acceptance.ClientCheckFunc {
+	return func(ctx context.Context, client *clients.Client, state *terraform.InstanceState) error {
+		id, err := parse.StorageContainerDataPlaneID(state.ID)
+		if err != nil {
+			return err
+		}
+		account, err := client.Storage.FindAccount(ctx, id.AccountName)
+		if err != nil {
+			return fmt.Errorf("retrieving Account %q for Container %q: %+v", id.AccountName, id.Name, err)
+		}
+		if account == nil {
+			return fmt.Errorf("unable to locate Storage Account %q", id.AccountName)
+		}
+		containersClient, err := client.Storage.ContainersClient(ctx, *account)
+		if err != nil {
+			return fmt.Errorf("building Containers Client: %+v", err)
+		}
+		props, err := containersClient.Get(ctx, account.ResourceGroup, id.AccountName, id.Name)
+		if err != nil {
+			return fmt.Errorf("retrieving Container %q (Account %q / Resource Group %q): %+v", id.Name, id.AccountName, account.ResourceGroup, err)
+		}
+		if props == nil {
+			return fmt.Errorf("Container %q (Account %q / Resource Group %q) was not found", id.Name, id.AccountName, account.ResourceGroup)
+		}
+		if !reflect.DeepEqual(props.MetaData, expected) {
+			return fmt.Errorf("expected the MetaData to be %+v but got %+v", expected, props.MetaData)
+		}
+		return nil
+	}
+}
+
 func (r StorageContainerResource) basic(data acceptance.TestData) string {
 	template := r.template(data)
 	return fmt.Sprintf(`
@@ -277,6 +535,69 @@ resource "azurerm_storage_container" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomString)
 }
 
+func (r StorageContainerResource) metaDataSharedKeyDisabled(data acceptance.TestData, key, value string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  storage_use_azuread = true
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                      = "acctestacc%s"
+  resource_group_name       = azurerm_resource_group.test.name
+  location                  = azurerm_resource_group.test.location
+  account_tier              = "Standard"
+  account_replication_type  = "LRS"
+  shared_access_key_enabled = false
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "vhds"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+
+  metadata = {
+    %s = "%s"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, key, value)
+}
+
+func (r StorageContainerResource) accessTypeSharedKeyDisabled(data acceptance.TestData, accessType string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  storage_use_azuread = true
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                            = "acctestacc%s"
+  resource_group_name             = azurerm_resource_group.test.name
+  location                        = azurerm_resource_group.test.location
+  account_tier                    = "Standard"
+  account_replication_type        = "LRS"
+  allow_nested_items_to_be_public = true
+  shared_access_key_enabled       = false
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "vhds"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, accessType)
+}
+
 func (r StorageContainerResource) requiresImport(data acceptance.TestData) string {
 	template := r.basic(data)
 	return fmt.Sprintf(`
@@ -379,6 +700,116 @@ resource "azurerm_storage_container" "test" {
 `, template)
 }
 
+func (r StorageContainerResource) webStaticWebsite(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account_static_website_dataplane" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  index_document     = "index.html"
+  error_404_document = "404.html"
+
+  depends_on = [azurerm_storage_container.test]
+}
+`, r.web(data))
+}
+
+func (r StorageContainerResource) defaultMetaData(data acceptance.TestData, overrideOwner bool) string {
+	owner := ""
+	if overrideOwner {
+		owner = `owner = "data"`
+	}
+
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+
+  storage_default_container_metadata = {
+    owner = "platform"
+  }
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "vhds"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+
+  metadata = {
+    hello = "world"
+    %s
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, owner)
+}
+
+func (r StorageContainerResource) resourceManager(data acceptance.TestData, accessType, value string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+
+  storage_use_resource_manager_for_containers = true
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                            = "acctestacc%s"
+  resource_group_name             = azurerm_resource_group.test.name
+  location                        = azurerm_resource_group.test.location
+  account_tier                    = "Standard"
+  account_replication_type        = "LRS"
+  allow_nested_items_to_be_public = true
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "vhds"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "%s"
+
+  metadata = {
+    hello = "%s"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, accessType, value)
+}
+
+func (r StorageContainerResource) encryptionScope(data acceptance.TestData, overrideEnabled bool) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_encryption_scope" "test" {
+  name               = "acctestES%d"
+  storage_account_id = azurerm_storage_account.test.id
+  source             = "Microsoft.Storage"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                              = "vhds"
+  storage_account_name              = azurerm_storage_account.test.name
+  container_access_type             = "private"
+  default_encryption_scope          = azurerm_storage_encryption_scope.test.name
+  encryption_scope_override_enabled = %t
+}
+`, template, data.RandomInteger, overrideEnabled)
+}
+
 func (r StorageContainerResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/blob/containers"
+)
+
+type storageContainerUsageDataSource struct{}
+
+var _ sdk.DataSource = storageContainerUsageDataSource{}
+
+type storageContainerUsageDataSourceModel struct {
+	StorageContainerId string `tfschema:"storage_container_id"`
+	Prefix             string `tfschema:"prefix"`
+	BlobCount          int64  `tfschema:"blob_count"`
+	TotalSizeInBytes   int64  `tfschema:"total_size_in_bytes"`
+}
+
+func (r storageContainerUsageDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_container_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.StorageContainerDataPlaneID,
+		},
+
+		"prefix": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r storageContainerUsageDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"blob_count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"total_size_in_bytes": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+	}
+}
+
+func (r storageContainerUsageDataSource) ResourceType() string {
+	return "azurerm_storage_container_usage"
+}
+
+func (r storageContainerUsageDataSource) ModelObject() interface{} {
+	return &storageContainerUsageDataSourceModel{}
+}
+
+func (r storageContainerUsageDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		// the usage is calculated by listing every Blob within the Container, which can take some time for
+		// Containers with a large number of Blobs
+		Timeout: 30 * time.Minute,
+
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			var plan storageContainerUsageDataSourceModel
+			if err := metadata.Decode(&plan); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			id, err := parse.StorageContainerDataPlaneID(plan.StorageContainerId)
+			if err != nil {
+				return err
+			}
+
+			account, err := storageClient.FindAccount(ctx, id.AccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving Account %q for Container %q: %+v", id.AccountName, id.Name, err)
+			}
+			if account == nil {
+				return fmt.Errorf("unable to locate Storage Account %q", id.AccountName)
+			}
+
+			containersClient, err := storageClient.ContainersClient(ctx, *account)
+			if err != nil {
+				return fmt.Errorf("building Containers Client: %+v", err)
+			}
+
+			input := containers.ListBlobsInput{}
+			if plan.Prefix != "" {
+				input.Prefix = pointer.To(plan.Prefix)
+			}
+
+			// there's no API which returns the usage of a Container, so this is calculated from the Blob listing
+			// which is paged through in its entirety - this is expensive (both in time and transactions) for
+			// Containers with a large number of Blobs
+			items, err := containersClient.ListBlobs(ctx, account.ResourceGroup, id.AccountName, id.Name, input)
+			if err != nil {
+				return fmt.Errorf("listing Blobs within %s: %+v", id, err)
+			}
+
+			plan.BlobCount = int64(len(*items))
+			plan.TotalSizeInBytes = 0
+			for _, item := range *items {
+				if props := item.Properties; props != nil {
+					plan.TotalSizeInBytes += pointer.From(props.ContentLength)
+				}
+			}
+
+			if err := metadata.Encode(&plan); err != nil {
+				return fmt.Errorf("encoding %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type storageContainerUsageDataSource struct{}
+
+func TestAccDataSourceStorageContainerUsage_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_container_usage", "test")
+	d := storageContainerUsageDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: d.basic(data, "null"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("blob_count").HasValue("2"),
+				check.That(data.ResourceName).Key("total_size_in_bytes").HasValue("11"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceStorageContainerUsage_prefix(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_container_usage", "test")
+	d := storageContainerUsageDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: d.basic(data, `"logs/"`),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("blob_count").HasValue("1"),
+				check.That(data.ResourceName).Key("total_size_in_bytes").HasValue("5"),
+			),
+		},
+	})
+}
+
+func (d storageContainerUsageDataSource) basic(data acceptance.TestData, prefix string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "test"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+
+resource "azurerm_storage_blob" "one" {
+  name                   = "logs/one.txt"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Block"
+  source_content         = "hello"
+}
+
+resource "azurerm_storage_blob" "other" {
+  name                   = "other.txt"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Block"
+  source_content         = "world!"
+}
+
+data "azurerm_storage_container_usage" "test" {
+  storage_container_id = azurerm_storage_container.test.id
+  prefix               = %s
+
+  depends_on = [azurerm_storage_blob.one, azurerm_storage_blob.other]
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, prefix)
+}
@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/blobcontainers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -98,7 +99,14 @@ func (r storageContainersDataSource) Read() sdk.ResourceFunc {
 				return err
 			}
 
-			resp, err := client.ListCompleteMatchingPredicate(ctx, *id, blobcontainers.DefaultListOperationOptions(), blobcontainers.ListContainerItemOperationPredicate{})
+			// the prefix is also matched by the API, so that only the matching Containers are retrieved when there are
+			// many Containers within the Storage Account (e.g. when these are being imported in bulk)
+			options := blobcontainers.DefaultListOperationOptions()
+			if plan.NamePrefix != "" {
+				options.Filter = pointer.To(plan.NamePrefix)
+			}
+
+			resp, err := client.ListCompleteMatchingPredicate(ctx, *id, options, blobcontainers.ListContainerItemOperationPredicate{})
 			if err != nil {
 				return fmt.Errorf("retrieving %s: %+v", id, err)
 			}
@@ -4,16 +4,22 @@
 package storage
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"log"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	intStor "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/client"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
@@ -58,14 +64,96 @@ func resourceStorageQueue() *pluginsdk.Resource {
 
 			"metadata": MetaDataSchema(),
 
+			"approximate_message_count_alert_threshold": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"acl": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				MaxItems: 5,
+				Set:      resourceStorageQueueACLHash,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 64),
+						},
+						"access_policy": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"start": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+									"expiry": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+									"permissions": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+										DiffSuppressFunc: func(_, old, new string, _ *pluginsdk.ResourceData) bool {
+											return normalizeStorageQueueACLPermissions(old) == normalizeStorageQueueACLPermissions(new)
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"approximate_message_count": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"authentication_method": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"resource_manager_id": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceStorageQueueCustomizeDiff),
 	}
 }
 
+// storageQueueAlertThresholdMetaDataKey is the MetaData key used to store the `approximate_message_count_alert_threshold`
+// on the Queue, so that this is available to the tooling alerting on the depth of the Queue
+const storageQueueAlertThresholdMetaDataKey = "approximate_message_count_alert_threshold"
+
+func resourceStorageQueueCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+	if _, ok := diff.Get("metadata").(map[string]interface{})[storageQueueAlertThresholdMetaDataKey]; ok {
+		return fmt.Errorf("the `metadata` key %q is managed using the `approximate_message_count_alert_threshold` property and so can't be specified within `metadata`", storageQueueAlertThresholdMetaDataKey)
+	}
+
+	return nil
+}
+
+// expandStorageQueueMetaData returns the MetaData for the Queue, including the `approximate_message_count_alert_threshold`
+func expandStorageQueueMetaData(d *pluginsdk.ResourceData) map[string]string {
+	metaData := ExpandMetaData(d.Get("metadata").(map[string]interface{}))
+	if v := d.Get("approximate_message_count_alert_threshold").(int); v > 0 {
+		metaData[storageQueueAlertThresholdMetaDataKey] = strconv.Itoa(v)
+	}
+	return metaData
+}
+
 func resourceStorageQueueCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	storageClient := meta.(*clients.Client).Storage
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
@@ -74,8 +162,7 @@ func resourceStorageQueueCreate(d *pluginsdk.ResourceData, meta interface{}) err
 	queueName := d.Get("name").(string)
 	accountName := d.Get("storage_account_name").(string)
 
-	metaDataRaw := d.Get("metadata").(map[string]interface{})
-	metaData := ExpandMetaData(metaDataRaw)
+	metaData := expandStorageQueueMetaData(d)
 
 	account, err := storageClient.FindAccount(ctx, accountName)
 	if err != nil {
@@ -90,9 +177,17 @@ func resourceStorageQueueCreate(d *pluginsdk.ResourceData, meta interface{}) err
 		return fmt.Errorf("building Queues Client: %s", err)
 	}
 
-	resourceId := parse.NewStorageQueueDataPlaneId(accountName, storageClient.Environment.StorageEndpointSuffix, queueName).ID()
+	host, err := account.DataPlaneHost(intStor.EndpointTypeQueue)
+	if err != nil {
+		return err
+	}
+	dataPlaneId := parse.NewStorageQueueDataPlaneId(accountName, host.DomainSuffix, queueName)
+	dataPlaneId.DnsZone = host.DnsZone
+	resourceId := dataPlaneId.ID()
 
-	exists, err := client.Exists(ctx, account.ResourceGroup, accountName, queueName)
+	exists, err := checkExistsWithTimeout(ctx, func(ctx context.Context) (*bool, error) {
+		return client.Exists(ctx, account.ResourceGroup, accountName, queueName)
+	})
 	if err != nil {
 		return fmt.Errorf("checking for presence of existing Queue %q (Storage Account %q): %s", queueName, accountName, err)
 	}
@@ -104,6 +199,18 @@ func resourceStorageQueueCreate(d *pluginsdk.ResourceData, meta interface{}) err
 		return fmt.Errorf("creating Queue %q (Account %q): %+v", queueName, accountName, err)
 	}
 
+	if aclsRaw := d.Get("acl").(*pluginsdk.Set).List(); len(aclsRaw) > 0 {
+		aclClient, err := storageClient.QueueACLClient(ctx, *account)
+		if err != nil {
+			return fmt.Errorf("building Queue ACL Client: %s", err)
+		}
+
+		acls := expandStorageQueueACLs(aclsRaw)
+		if _, err := aclClient.SetACL(ctx, accountName, queueName, acls); err != nil {
+			return fmt.Errorf("setting ACL's for Queue %q (Account %q): %+v", queueName, accountName, intStor.RejectedSignedIdentifierError(err, storageQueueSignedIdentifiers(acls)))
+		}
+	}
+
 	d.SetId(resourceId)
 	return resourceStorageQueueRead(d, meta)
 }
@@ -118,8 +225,7 @@ func resourceStorageQueueUpdate(d *pluginsdk.ResourceData, meta interface{}) err
 		return err
 	}
 
-	metaDataRaw := d.Get("metadata").(map[string]interface{})
-	metaData := ExpandMetaData(metaDataRaw)
+	metaData := expandStorageQueueMetaData(d)
 
 	account, err := storageClient.FindAccount(ctx, id.AccountName)
 	if err != nil {
@@ -134,8 +240,26 @@ func resourceStorageQueueUpdate(d *pluginsdk.ResourceData, meta interface{}) err
 		return fmt.Errorf("building Queues Client: %s", err)
 	}
 
-	if err := client.UpdateMetaData(ctx, account.ResourceGroup, id.AccountName, id.Name, metaData); err != nil {
-		return fmt.Errorf("updating MetaData for Queue %q (Storage Account %q): %s", id.Name, id.AccountName, err)
+	if d.HasChanges("metadata", "approximate_message_count_alert_threshold") {
+		if err := client.UpdateMetaData(ctx, account.ResourceGroup, id.AccountName, id.Name, metaData); err != nil {
+			return fmt.Errorf("updating MetaData for Queue %q (Storage Account %q): %s", id.Name, id.AccountName, err)
+		}
+	}
+
+	if d.HasChange("acl") {
+		log.Printf("[DEBUG] Updating the ACL's for Queue %q (Storage Account %q)", id.Name, id.AccountName)
+
+		aclClient, err := storageClient.QueueACLClient(ctx, *account)
+		if err != nil {
+			return fmt.Errorf("building Queue ACL Client: %s", err)
+		}
+
+		acls := expandStorageQueueACLs(d.Get("acl").(*pluginsdk.Set).List())
+		if _, err := aclClient.SetACL(ctx, id.AccountName, id.Name, acls); err != nil {
+			return fmt.Errorf("updating ACL's for Queue %q (Storage Account %q): %+v", id.Name, id.AccountName, intStor.RejectedSignedIdentifierError(err, storageQueueSignedIdentifiers(acls)))
+		}
+
+		log.Printf("[DEBUG] Updated the ACL's for Queue %q (Storage Account %q)", id.Name, id.AccountName)
 	}
 
 	return resourceStorageQueueRead(d, meta)
@@ -177,13 +301,64 @@ func resourceStorageQueueRead(d *pluginsdk.ResourceData, meta interface{}) error
 		return nil
 	}
 
+	// the host within the ID differs from that of the Storage Account's endpoint when the state has been moved between
+	// clouds, or when the ID was built without the DNS Zone used by the Storage Account - since the Queue exists the ID
+	// is updated, rather than the Queue needing to be recreated
+	host, err := account.DataPlaneHost(intStor.EndpointTypeQueue)
+	if err != nil {
+		return err
+	}
+	if id.DomainSuffix != host.DomainSuffix || id.DnsZone != host.DnsZone {
+		newId := *id
+		newId.DomainSuffix = host.DomainSuffix
+		newId.DnsZone = host.DnsZone
+		log.Printf("[DEBUG] Updating the ID for %s to use the Domain Suffix %q and DNS Zone %q", id, host.DomainSuffix, host.DnsZone)
+		d.SetId(newId.ID())
+	}
+
 	d.Set("name", id.Name)
 	d.Set("storage_account_name", id.AccountName)
+	d.Set("authentication_method", storageClient.DataPlaneAuthenticationMethod())
+
+	// the alert threshold is stored within the MetaData, but is exposed separately - where this has been changed to
+	// a value which isn't a valid threshold outside of Terraform it's left within the `metadata` to surface a diff
+	alertThreshold := 0
+	if v, ok := queue.MetaData[storageQueueAlertThresholdMetaDataKey]; ok {
+		if threshold, err := strconv.Atoi(v); err == nil && threshold > 0 {
+			alertThreshold = threshold
+			delete(queue.MetaData, storageQueueAlertThresholdMetaDataKey)
+		} else {
+			log.Printf("[WARN] the MetaData %q for Queue %q (Storage Account %q) isn't a valid alert threshold: %q", storageQueueAlertThresholdMetaDataKey, id.Name, id.AccountName, v)
+		}
+	}
+	d.Set("approximate_message_count_alert_threshold", alertThreshold)
+	d.Set("approximate_message_count", queue.ApproximateMessageCount)
 
 	if err := d.Set("metadata", FlattenMetaData(queue.MetaData)); err != nil {
 		return fmt.Errorf("setting `metadata`: %s", err)
 	}
 
+	aclClient, err := storageClient.QueueACLClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building Queue ACL Client: %s", err)
+	}
+
+	acls := make([]intStor.QueueSignedIdentifier, 0)
+	result, err := aclClient.GetACL(ctx, id.AccountName, id.Name)
+	if err != nil {
+		// as with Tables, ACL's aren't supported for Queues within some kinds of Storage Account
+		if !intStor.DataPlaneOperationNotSupported(err) {
+			return fmt.Errorf("retrieving ACL's for Queue %q (Account %q): %+v", id.Name, id.AccountName, err)
+		}
+		log.Printf("[DEBUG] ACL's aren't supported for Storage Queues within Storage Account %q (Kind %q) - assuming there are none", id.AccountName, account.Kind)
+	} else {
+		acls = result.SignedIdentifiers
+	}
+
+	if err := d.Set("acl", flattenStorageQueueACLs(acls)); err != nil {
+		return fmt.Errorf("setting `acl`: %+v", err)
+	}
+
 	resourceManagerId := parse.NewStorageQueueResourceManagerID(subscriptionId, account.ResourceGroup, id.AccountName, "default", id.Name)
 	d.Set("resource_manager_id", resourceManagerId.ID())
 
@@ -221,3 +396,95 @@ func resourceStorageQueueDelete(d *pluginsdk.ResourceData, meta interface{}) err
 
 	return nil
 }
+
+func expandStorageQueueACLs(input []interface{}) []intStor.QueueSignedIdentifier {
+	results := make([]intStor.QueueSignedIdentifier, 0)
+
+	for _, v := range input {
+		vals := v.(map[string]interface{})
+
+		policies := vals["access_policy"].([]interface{})
+		policy := policies[0].(map[string]interface{})
+
+		identifier := intStor.QueueSignedIdentifier{
+			Id: vals["id"].(string),
+			AccessPolicy: intStor.QueueAccessPolicy{
+				Start:      policy["start"].(string),
+				Expiry:     policy["expiry"].(string),
+				Permission: normalizeStorageQueueACLPermissions(policy["permissions"].(string)),
+			},
+		}
+		results = append(results, identifier)
+	}
+
+	return results
+}
+
+// storageQueueSignedIdentifiers returns the ACL's in the form used to identify which of these was rejected
+func storageQueueSignedIdentifiers(input []intStor.QueueSignedIdentifier) []intStor.SignedIdentifier {
+	results := make([]intStor.SignedIdentifier, 0)
+	for _, v := range input {
+		results = append(results, intStor.SignedIdentifier{
+			Id:         v.Id,
+			Start:      v.AccessPolicy.Start,
+			Expiry:     v.AccessPolicy.Expiry,
+			Permission: v.AccessPolicy.Permission,
+		})
+	}
+	return results
+}
+
+func flattenStorageQueueACLs(input []intStor.QueueSignedIdentifier) []interface{} {
+	result := make([]interface{}, 0)
+
+	for _, v := range input {
+		output := map[string]interface{}{
+			"id": v.Id,
+			"access_policy": []interface{}{
+				map[string]interface{}{
+					"start":       v.AccessPolicy.Start,
+					"expiry":      v.AccessPolicy.Expiry,
+					"permissions": normalizeStorageQueueACLPermissions(v.AccessPolicy.Permission),
+				},
+			},
+		}
+
+		result = append(result, output)
+	}
+
+	return result
+}
+
+// normalizeStorageQueueACLPermissions returns the permissions in the order used by the Queue Service (`raup`),
+// which canonicalizes this value when the ACL's are set.
+func normalizeStorageQueueACLPermissions(input string) string {
+	output := ""
+	for _, c := range "raup" {
+		if strings.ContainsRune(input, c) {
+			output += string(c)
+		}
+	}
+	return output
+}
+
+func resourceStorageQueueACLHash(v interface{}) int {
+	var buf bytes.Buffer
+
+	if m, ok := v.(map[string]interface{}); ok {
+		buf.WriteString(fmt.Sprintf("%s-", m["id"].(string)))
+
+		if policies, ok := m["access_policy"].([]interface{}); ok {
+			for _, raw := range policies {
+				policy, ok := raw.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				buf.WriteString(fmt.Sprintf("%s-", policy["start"].(string)))
+				buf.WriteString(fmt.Sprintf("%s-", policy["expiry"].(string)))
+				buf.WriteString(fmt.Sprintf("%s-", normalizeStorageQueueACLPermissions(policy["permissions"].(string))))
+			}
+		}
+	}
+
+	return pluginsdk.HashString(buf.String())
+}
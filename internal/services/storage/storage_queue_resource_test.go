@@ -85,6 +85,70 @@ func TestAccStorageQueue_metaData(t *testing.T) {
 	})
 }
 
+func TestAccStorageQueue_approximateMessageCountAlertThreshold(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_queue", "test")
+	r := StorageQueueResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.approximateMessageCountAlertThreshold(data, 100),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("approximate_message_count").HasValue("0"),
+				check.That(data.ResourceName).Key("metadata.%").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.approximateMessageCountAlertThreshold(data, 250),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.metaData(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("approximate_message_count_alert_threshold").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageQueue_acl(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_queue", "test")
+	r := StorageQueueResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.acl(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("acl.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.aclUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("acl.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.metaData(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("acl.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r StorageQueueResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.StorageQueueDataPlaneID(state.ID)
 	if err != nil {
@@ -179,6 +243,86 @@ resource "azurerm_storage_queue" "test" {
 `, template, data.RandomInteger)
 }
 
+func (r StorageQueueResource) approximateMessageCountAlertThreshold(data acceptance.TestData, threshold int) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_queue" "test" {
+  name                 = "mysamplequeue-%d"
+  storage_account_name = azurerm_storage_account.test.name
+
+  approximate_message_count_alert_threshold = %d
+
+  metadata = {
+    hello = "world"
+  }
+}
+`, template, data.RandomInteger, threshold)
+}
+
+func (r StorageQueueResource) acl(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_queue" "test" {
+  name                 = "mysamplequeue-%d"
+  storage_account_name = azurerm_storage_account.test.name
+
+  metadata = {
+    hello = "world"
+  }
+
+  acl {
+    id = "MTIzNDU2Nzg5MDEyMzQ1Njc4OTAxMjM0NTY3ODkwMTI"
+
+    access_policy {
+      permissions = "raup"
+      start       = "2019-07-02T09:38:21.0000000Z"
+      expiry      = "2099-07-02T10:38:21.0000000Z"
+    }
+  }
+}
+`, template, data.RandomInteger)
+}
+
+func (r StorageQueueResource) aclUpdated(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_queue" "test" {
+  name                 = "mysamplequeue-%d"
+  storage_account_name = azurerm_storage_account.test.name
+
+  metadata = {
+    hello = "world"
+  }
+
+  acl {
+    id = "AAAANDU2Nzg5MDEyMzQ1Njc4OTAxMjM0NTY3ODkwMTI"
+
+    access_policy {
+      permissions = "ar"
+      start       = "2019-07-02T09:38:21.0000000Z"
+      expiry      = "2099-07-02T10:38:21.0000000Z"
+    }
+  }
+
+  acl {
+    id = "MTIzNDU2Nzg5MDEyMzQ1Njc4OTAxMjM0NTY3ODkwMTI"
+
+    access_policy {
+      permissions = "raup"
+      start       = "2019-07-02T09:38:21.0000000Z"
+      expiry      = "2099-07-02T10:38:21.0000000Z"
+    }
+  }
+}
+`, template, data.RandomInteger)
+}
+
 func (r StorageQueueResource) metaDataUpdated(data acceptance.TestData) string {
 	template := r.template(data)
 	return fmt.Sprintf(`
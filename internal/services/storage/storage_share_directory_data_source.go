@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceStorageShareDirectory() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceStorageShareDirectoryRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.StorageShareDirectoryName,
+			},
+
+			"share_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"storage_account_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"metadata": MetaDataComputedSchema(),
+		},
+	}
+}
+
+func dataSourceStorageShareDirectoryRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	directoryName := d.Get("name").(string)
+	shareName := d.Get("share_name").(string)
+	accountName := d.Get("storage_account_name").(string)
+
+	account, err := storageClient.FindAccount(ctx, accountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Directory %q (Share %q): %s", accountName, directoryName, shareName, err)
+	}
+	if account == nil {
+		return fmt.Errorf("unable to locate Account %q for Directory %q (Share %q)", accountName, directoryName, shareName)
+	}
+
+	client, err := storageClient.FileShareDirectoriesClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building File Share Directories Client for Storage Account %q (Resource Group %q): %s", accountName, account.ResourceGroup, err)
+	}
+
+	props, err := client.Get(ctx, accountName, shareName, directoryName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(props.Response) {
+			return fmt.Errorf("retrieving Directory %q (File Share %q / Account %q / Resource Group %q): %s", directoryName, shareName, accountName, account.ResourceGroup, err)
+		}
+
+		// a 404 is returned both when the Directory and when the Share doesn't exist, so check the Share to surface which
+		sharesClient, err := storageClient.FileSharesClient(ctx, *account)
+		if err != nil {
+			return fmt.Errorf("building FileShares Client for Storage Account %q (Resource Group %q): %s", accountName, account.ResourceGroup, err)
+		}
+		shareExists, err := sharesClient.Exists(ctx, account.ResourceGroup, accountName, shareName)
+		if err != nil {
+			return fmt.Errorf("checking for the presence of File Share %q (Account %q / Resource Group %q): %s", shareName, accountName, account.ResourceGroup, err)
+		}
+		if shareExists != nil && !*shareExists {
+			return fmt.Errorf("File Share %q was not found in Account %q / Resource Group %q", shareName, accountName, account.ResourceGroup)
+		}
+
+		return fmt.Errorf("Directory %q was not found in File Share %q (Account %q / Resource Group %q)", directoryName, shareName, accountName, account.ResourceGroup)
+	}
+
+	d.SetId(client.GetResourceID(accountName, shareName, directoryName))
+
+	d.Set("name", directoryName)
+	d.Set("share_name", shareName)
+	d.Set("storage_account_name", accountName)
+
+	if err := d.Set("metadata", FlattenMetaData(props.MetaData)); err != nil {
+		return fmt.Errorf("setting `metadata`: %+v", err)
+	}
+
+	return nil
+}
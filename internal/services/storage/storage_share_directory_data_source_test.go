@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type StorageShareDirectoryDataSource struct{}
+
+func TestAccDataSourceStorageShareDirectory_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_share_directory", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: StorageShareDirectoryDataSource{}.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("id").Exists(),
+				check.That(data.ResourceName).Key("metadata.%").HasValue("1"),
+				check.That(data.ResourceName).Key("metadata.hello").HasValue("world"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceStorageShareDirectory_directoryNotFound(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_share_directory", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config:      StorageShareDirectoryDataSource{}.directoryNotFound(data),
+			ExpectError: regexp.MustCompile("Directory \"missing\" was not found"),
+		},
+	})
+}
+
+func TestAccDataSourceStorageShareDirectory_shareNotFound(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_share_directory", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config:      StorageShareDirectoryDataSource{}.shareNotFound(data),
+			ExpectError: regexp.MustCompile("File Share \"missing\" was not found"),
+		},
+	})
+}
+
+func (d StorageShareDirectoryDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_storage_share_directory" "test" {
+  name                 = azurerm_storage_share_directory.test.name
+  share_name           = azurerm_storage_share_directory.test.share_name
+  storage_account_name = azurerm_storage_share_directory.test.storage_account_name
+}
+`, StorageShareDirectoryResource{}.complete(data))
+}
+
+func (d StorageShareDirectoryDataSource) directoryNotFound(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_storage_share_directory" "test" {
+  name                 = "missing"
+  share_name           = azurerm_storage_share.test.name
+  storage_account_name = azurerm_storage_account.test.name
+}
+`, StorageShareDirectoryResource{}.template(data))
+}
+
+func (d StorageShareDirectoryDataSource) shareNotFound(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_storage_share_directory" "test" {
+  name                 = "dir"
+  share_name           = "missing"
+  storage_account_name = azurerm_storage_account.test.name
+}
+`, StorageShareDirectoryResource{}.template(data))
+}
@@ -4,6 +4,7 @@
 package storage
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-09-01/storage" // nolint: staticcheck
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	intStor "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/client"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
@@ -178,9 +180,17 @@ func resourceStorageShareCreate(d *pluginsdk.ResourceData, meta interface{}) err
 		return fmt.Errorf("building File Share Client: %s", err)
 	}
 
-	id := parse.NewStorageShareDataPlaneId(accountName, storageClient.Environment.StorageEndpointSuffix, shareName).ID()
+	host, err := account.DataPlaneHost(intStor.EndpointTypeFile)
+	if err != nil {
+		return err
+	}
+	dataPlaneId := parse.NewStorageShareDataPlaneId(accountName, host.DomainSuffix, shareName)
+	dataPlaneId.DnsZone = host.DnsZone
+	id := dataPlaneId.ID()
 
-	exists, err := client.Exists(ctx, account.ResourceGroup, accountName, shareName)
+	exists, err := checkExistsWithTimeout(ctx, func(ctx context.Context) (*bool, error) {
+		return client.Exists(ctx, account.ResourceGroup, accountName, shareName)
+	})
 	if err != nil {
 		return fmt.Errorf("checking for existence of existing Storage Share %q (Account %q / Resource Group %q): %+v", shareName, accountName, account.ResourceGroup, err)
 	}
@@ -206,7 +216,7 @@ func resourceStorageShareCreate(d *pluginsdk.ResourceData, meta interface{}) err
 
 	d.SetId(id)
 	if err := client.UpdateACLs(ctx, account.ResourceGroup, accountName, shareName, acls); err != nil {
-		return fmt.Errorf("setting ACL's for Share %q (Account %q / Resource Group %q): %+v", shareName, accountName, account.ResourceGroup, err)
+		return fmt.Errorf("setting ACL's for Share %q (Account %q / Resource Group %q): %+v", shareName, accountName, account.ResourceGroup, intStor.RejectedSignedIdentifierError(err, storageShareSignedIdentifiers(acls)))
 	}
 
 	return resourceStorageShareRead(d, meta)
@@ -247,17 +257,28 @@ func resourceStorageShareRead(d *pluginsdk.ResourceData, meta interface{}) error
 		return nil
 	}
 
+	// the host within the ID differs from that of the Storage Account's endpoint when the state has been moved between
+	// clouds, or when the ID was built without the DNS Zone used by the Storage Account - since the File Share exists the ID
+	// is updated, rather than the File Share needing to be recreated
+	host, err := account.DataPlaneHost(intStor.EndpointTypeFile)
+	if err != nil {
+		return err
+	}
+	if id.DomainSuffix != host.DomainSuffix || id.DnsZone != host.DnsZone {
+		newId := *id
+		newId.DomainSuffix = host.DomainSuffix
+		newId.DnsZone = host.DnsZone
+		log.Printf("[DEBUG] Updating the ID for %s to use the Domain Suffix %q and DNS Zone %q", id, host.DomainSuffix, host.DnsZone)
+		d.SetId(newId.ID())
+	}
+
 	d.Set("name", id.Name)
 	d.Set("storage_account_name", id.AccountName)
 	d.Set("quota", props.QuotaGB)
 	d.Set("url", id.ID())
 	d.Set("enabled_protocol", string(props.EnabledProtocol))
 
-	accessTier := ""
-	if props.AccessTier != nil {
-		accessTier = string(*props.AccessTier)
-	}
-	d.Set("access_tier", accessTier)
+	d.Set("access_tier", flattenStorageShareAccessTier(props.AccessTier, account.Kind == storage.KindFileStorage))
 
 	if err := d.Set("acl", flattenStorageShareACLs(props.ACLs)); err != nil {
 		return fmt.Errorf("flattening `acl`: %+v", err)
@@ -327,7 +348,7 @@ func resourceStorageShareUpdate(d *pluginsdk.ResourceData, meta interface{}) err
 		acls := expandStorageShareACLs(aclsRaw)
 
 		if err := client.UpdateACLs(ctx, account.ResourceGroup, id.AccountName, id.Name, acls); err != nil {
-			return fmt.Errorf("updating ACL's for File Share %q (Storage Account %q): %s", id.Name, id.AccountName, err)
+			return fmt.Errorf("updating ACL's for File Share %q (Storage Account %q): %s", id.Name, id.AccountName, intStor.RejectedSignedIdentifierError(err, storageShareSignedIdentifiers(acls)))
 		}
 
 		log.Printf("[DEBUG] Updated the ACL's for File Share %q (Storage Account %q)", id.Name, id.AccountName)
@@ -377,6 +398,18 @@ func resourceStorageShareDelete(d *pluginsdk.ResourceData, meta interface{}) err
 	return nil
 }
 
+// flattenStorageShareAccessTier returns the Access Tier of the File Share - File Shares within a `FileStorage` (Premium)
+// Storage Account are always in the Premium tier, which is used when the Access Tier isn't returned by the API
+func flattenStorageShareAccessTier(input *shares.AccessTier, isPremiumAccount bool) string {
+	if input != nil {
+		return string(*input)
+	}
+	if isPremiumAccount {
+		return string(shares.PremiumAccessTier)
+	}
+	return ""
+}
+
 func expandStorageShareACLs(input []interface{}) []shares.SignedIdentifier {
 	results := make([]shares.SignedIdentifier, 0)
 
@@ -400,6 +433,20 @@ func expandStorageShareACLs(input []interface{}) []shares.SignedIdentifier {
 	return results
 }
 
+// storageShareSignedIdentifiers returns the ACL's in the form used to identify which of these was rejected
+func storageShareSignedIdentifiers(input []shares.SignedIdentifier) []intStor.SignedIdentifier {
+	results := make([]intStor.SignedIdentifier, 0)
+	for _, v := range input {
+		results = append(results, intStor.SignedIdentifier{
+			Id:         v.Id,
+			Start:      v.AccessPolicy.Start,
+			Expiry:     v.AccessPolicy.Expiry,
+			Permission: v.AccessPolicy.Permission,
+		})
+	}
+	return results
+}
+
 func flattenStorageShareACLs(input []shares.SignedIdentifier) []interface{} {
 	result := make([]interface{}, 0)
 
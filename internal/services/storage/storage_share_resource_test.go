@@ -105,6 +105,42 @@ func TestAccStorageShare_metaData(t *testing.T) {
 			),
 		},
 		data.ImportStep(),
+		{
+			Config: r.metaDataEmpty(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("metadata.%").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageShare_metaDataConvergesAfterDrift(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_share", "test")
+	r := StorageShareResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			// the MetaData is changed outside of Terraform, which should be detected and then reconciled
+			Config: r.metaData(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.updateMetaDataOutOfBand(map[string]string{
+					"hello": "mismatch",
+				})),
+			),
+			ExpectNonEmptyPlan: true,
+		},
+		{
+			Config: r.metaData(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("metadata.%").HasValue("1"),
+				check.That(data.ResourceName).Key("metadata.hello").HasValue("world"),
+			),
+		},
+		data.ImportStep(),
 	})
 }
 
@@ -241,6 +277,9 @@ func TestAccStorageShare_nfsProtocol(t *testing.T) {
 			Config: r.protocol(data, "NFS"),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled_protocol").HasValue("NFS"),
+				// File Shares within a FileStorage Storage Account are always Premium, even when this isn't specified
+				check.That(data.ResourceName).Key("access_tier").HasValue("Premium"),
 			),
 		},
 		data.ImportStep(),
@@ -296,6 +335,30 @@ func (r StorageShareResource) Exists(ctx context.Context, client *clients.Client
 	return utils.Bool(props != nil), nil
 }
 
+func (r StorageShareResource) updateMetaDataOutOfBand(metaData map[string]string) acceptance.ClientCheckFunc {
+	return func(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) error {
+		id, err := parse.StorageShareDataPlaneID(state.ID)
+		if err != nil {
+			return err
+		}
+		account, err := client.Storage.FindAccount(ctx, id.AccountName)
+		if err != nil {
+			return fmt.Errorf("retrieving Account %q for Share %q: %+v", id.AccountName, id.Name, err)
+		}
+		if account == nil {
+			return fmt.Errorf("unable to locate Storage Account %q", id.AccountName)
+		}
+		sharesClient, err := client.Storage.FileSharesClient(ctx, *account)
+		if err != nil {
+			return fmt.Errorf("building File Share Client: %+v", err)
+		}
+		if err := sharesClient.UpdateMetaData(ctx, account.ResourceGroup, id.AccountName, id.Name, metaData); err != nil {
+			return fmt.Errorf("updating the MetaData for File Share %q (Account %q / Resource Group %q): %+v", id.Name, id.AccountName, account.ResourceGroup, err)
+		}
+		return nil
+	}
+}
+
 func (r StorageShareResource) Destroy(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.StorageShareDataPlaneID(state.ID)
 	if err != nil {
@@ -368,6 +431,21 @@ resource "azurerm_storage_share" "test" {
 `, template, data.RandomString)
 }
 
+func (r StorageShareResource) metaDataEmpty(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_share" "test" {
+  name                 = "testshare%s"
+  storage_account_name = azurerm_storage_account.test.name
+  quota                = 5
+
+  metadata = {}
+}
+`, template, data.RandomString)
+}
+
 func (r StorageShareResource) acl(data acceptance.TestData) string {
 	template := r.template(data)
 	return fmt.Sprintf(`
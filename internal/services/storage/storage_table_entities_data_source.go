@@ -5,6 +5,7 @@ package storage
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"strings"
@@ -27,9 +28,14 @@ type TableEntitiesDataSourceModel struct {
 	StorageAccountName string                        `tfschema:"storage_account_name"`
 	Filter             string                        `tfschema:"filter"`
 	Select             []string                      `tfschema:"select"`
+	Top                int                           `tfschema:"top"`
 	Items              []TableEntitiyDataSourceModel `tfschema:"items"`
+	ItemsJson          string                        `tfschema:"items_json"`
 }
 
+// storageTableEntitiesMaxPageSize is the maximum number of Entities which the Table Service returns in a single page
+const storageTableEntitiesMaxPageSize = 1000
+
 type TableEntitiyDataSourceModel struct {
 	PartitionKey string                 `tfschema:"partition_key"`
 	RowKey       string                 `tfschema:"row_key"`
@@ -63,6 +69,12 @@ func (k storageTableEntitiesDataSource) Arguments() map[string]*pluginsdk.Schema
 				Type: pluginsdk.TypeString,
 			},
 		},
+
+		"top": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
 	}
 }
 
@@ -93,6 +105,11 @@ func (k storageTableEntitiesDataSource) Attributes() map[string]*pluginsdk.Schem
 				},
 			},
 		},
+
+		"items_json": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
 	}
 }
 
@@ -128,9 +145,10 @@ func (k storageTableEntitiesDataSource) Read() sdk.ResourceFunc {
 				return fmt.Errorf("building Table Entity Client for Storage Account %q (Resource Group %q): %s", model.StorageAccountName, account.ResourceGroup, err)
 			}
 
+			// the full metadata is retrieved so that the type of each property can be included in `items_json`
 			input := entities.QueryEntitiesInput{
 				Filter:        &model.Filter,
-				MetaDataLevel: entities.MinimalMetaData,
+				MetaDataLevel: entities.FullMetaData,
 			}
 
 			if model.Select != nil {
@@ -140,13 +158,20 @@ func (k storageTableEntitiesDataSource) Read() sdk.ResourceFunc {
 
 			id := parse.NewStorageTableEntitiesId(model.StorageAccountName, storageClient.Environment.StorageEndpointSuffix, model.TableName, model.Filter)
 
-			result, err := client.Query(ctx, model.StorageAccountName, model.TableName, input)
+			results, err := queryStorageTableEntities(ctx, client, model.StorageAccountName, model.TableName, input, model.Top)
 			if err != nil {
 				return fmt.Errorf("retrieving Entities (Filter %q) (Table %q / Storage Account %q / Resource Group %q): %s", model.Filter, model.TableName, model.StorageAccountName, account.ResourceGroup, err)
 			}
 
+			// this is built before the Entities are flattened, since flattening removes the Timestamp from each Entity
+			itemsJson, err := flattenStorageTableEntitiesJSON(results)
+			if err != nil {
+				return fmt.Errorf("flattening `items_json`: %+v", err)
+			}
+			model.ItemsJson = itemsJson
+
 			var flattenedEntities []TableEntitiyDataSourceModel
-			for _, entity := range result.Entities {
+			for _, entity := range results {
 				flattenedEntity := flattenEntityWithMetadata(entity)
 				if len(flattenedEntity.Properties) == 0 {
 					// if we use selector, we get empty objects back, skip them
@@ -162,6 +187,84 @@ func (k storageTableEntitiesDataSource) Read() sdk.ResourceFunc {
 	}
 }
 
+// queryStorageTableEntities retrieves the Entities matching the specified input, paging through the results - when
+// `top` is greater than zero at most this many Entities are returned
+func queryStorageTableEntities(ctx context.Context, client *entities.Client, accountName, tableName string, input entities.QueryEntitiesInput, top int) ([]map[string]interface{}, error) {
+	// the Table Service returns the Entities in pages, where `$top` applies to each page - so the number of
+	// Entities requested for each page is reduced by those already retrieved, such that `top` is exact
+	results := make([]map[string]interface{}, 0)
+	for {
+		if top > 0 {
+			pageSize := top - len(results)
+			if pageSize > storageTableEntitiesMaxPageSize {
+				pageSize = storageTableEntitiesMaxPageSize
+			}
+			input.Top = &pageSize
+		}
+
+		result, err := client.Query(ctx, accountName, tableName, input)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result.Entities...)
+
+		if top > 0 && len(results) >= top {
+			return results[:top], nil
+		}
+		if result.NextPartitionKey == "" {
+			return results, nil
+		}
+		input.NextPartitionKey = &result.NextPartitionKey
+		input.NextRowKey = nil
+		if result.NextRowKey != "" {
+			input.NextRowKey = &result.NextRowKey
+		}
+	}
+}
+
+// flattenStorageTableEntitiesJSON returns the Entities as a JSON array, where each property which isn't an Edm.String
+// is annotated with its `@odata.type` - so that a snapshot of the Entities can be inserted again retaining the type
+// of each property. The OData metadata and Timestamp (which is assigned by the Table Service) are removed.
+func flattenStorageTableEntitiesJSON(input []map[string]interface{}) (string, error) {
+	output := make([]map[string]interface{}, 0)
+	for _, entity := range input {
+		item := make(map[string]interface{})
+		for k, v := range entity {
+			if strings.HasPrefix(k, "odata.") || k == "Timestamp" || k == "Timestamp@odata.type" {
+				continue
+			}
+			item[k] = v
+
+			if strings.HasSuffix(k, "@odata.type") {
+				continue
+			}
+			if _, ok := entity[k+"@odata.type"]; ok {
+				continue
+			}
+
+			// the types which can be inferred from the JSON value aren't annotated by the Table Service, so these are
+			// annotated here in the same manner as the `properties` within `items`
+			switch value := v.(type) {
+			case bool:
+				item[k+"@odata.type"] = "Edm.Boolean"
+			case float64:
+				if value == float64(int64(value)) {
+					item[k+"@odata.type"] = "Edm.Int32"
+				} else {
+					item[k+"@odata.type"] = "Edm.Double"
+				}
+			}
+		}
+		output = append(output, item)
+	}
+
+	result, err := json.Marshal(output)
+	if err != nil {
+		return "", err
+	}
+	return string(result), nil
+}
+
 // The api returns extra information that we already have. We'll remove it here before setting it in state.
 func flattenEntityWithMetadata(entity map[string]interface{}) TableEntitiyDataSourceModel {
 	delete(entity, "Timestamp")
@@ -188,7 +291,7 @@ func flattenEntityWithMetadata(entity map[string]interface{}) TableEntitiyDataSo
 			case "Edm.Boolean":
 				properties[k] = fmt.Sprint(v)
 			case "Edm.Double":
-				properties[k] = fmt.Sprintf("%f", v)
+				properties[k] = formatStorageTableEntityDouble(v)
 			case "Edm.Int32", "Edm.Int64":
 				// `v` returned as string for int 64
 				properties[k] = fmt.Sprint(v)
@@ -214,8 +317,7 @@ func flattenEntityWithMetadata(entity map[string]interface{}) TableEntitiyDataSo
 					properties[k] = fmt.Sprintf("%d", int64(f64))
 					properties[k+"@odata.type"] = "Edm.Int32"
 				} else {
-					// fmt.Sprintf("%f", v) will return `123.123000` for `123.123`, have to use fmt.Sprint
-					properties[k] = fmt.Sprint(v)
+					properties[k] = formatStorageTableEntityDouble(f64)
 					properties[k+"@odata.type"] = "Edm.Double"
 				}
 			case string:
@@ -21,6 +21,7 @@ func TestAccDataSourceStorageTableEntities_basic(t *testing.T) {
 			Config: StorageTableEntitiesDataSource{}.basicWithDataSource(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).Key("items.#").HasValue("2"),
+				check.That(data.ResourceName).Key("items_json").HasValue(`[{"PartitionKey":"testpartition","RowKey":"testrow","testkey":"testval"},{"PartitionKey":"testpartition","RowKey":"testrow2","testkey":"testval2"}]`),
 			),
 		},
 	})
@@ -39,6 +40,19 @@ func TestAccDataSourceStorageTableEntities_withSelector(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceStorageTableEntities_top(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_table_entities", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: StorageTableEntitiesDataSource{}.basicWithDataSourceAndTop(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("items.#").HasValue("1"),
+			),
+		},
+	})
+}
+
 func (d StorageTableEntitiesDataSource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -142,3 +156,22 @@ data "azurerm_storage_table_entities" "test" {
 }
 `, config)
 }
+
+func (d StorageTableEntitiesDataSource) basicWithDataSourceAndTop(data acceptance.TestData) string {
+	config := d.basic(data)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_storage_table_entities" "test" {
+  table_name           = azurerm_storage_table_entity.test.table_name
+  storage_account_name = azurerm_storage_table_entity.test.storage_account_name
+  filter               = "PartitionKey eq 'testpartition'"
+  top                  = 1
+
+  depends_on = [
+    azurerm_storage_table_entity.test,
+    azurerm_storage_table_entity.test2,
+  ]
+}
+`, config)
+}
@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	intStor "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/table/entities"
+)
+
+type StorageTableEntityCleanupResource struct{}
+
+var _ sdk.Resource = StorageTableEntityCleanupResource{}
+
+type StorageTableEntityCleanupModel struct {
+	StorageAccountName string `tfschema:"storage_account_name"`
+	TableName          string `tfschema:"table_name"`
+	Filter             string `tfschema:"filter"`
+	DryRun             bool   `tfschema:"dry_run"`
+	MatchedCount       int    `tfschema:"matched_count"`
+	DeletedCount       int    `tfschema:"deleted_count"`
+}
+
+func (r StorageTableEntityCleanupResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_account_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StorageAccountName,
+		},
+
+		"table_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StorageTableName,
+		},
+
+		"filter": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"dry_run": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  false,
+		},
+	}
+}
+
+func (r StorageTableEntityCleanupResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"matched_count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"deleted_count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+	}
+}
+
+func (r StorageTableEntityCleanupResource) ModelObject() interface{} {
+	return &StorageTableEntityCleanupModel{}
+}
+
+func (r StorageTableEntityCleanupResource) ResourceType() string {
+	return "azurerm_storage_table_entity_cleanup"
+}
+
+func (r StorageTableEntityCleanupResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.StorageTableEntitiesID
+}
+
+func (r StorageTableEntityCleanupResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			var model StorageTableEntityCleanupModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			account, err := storageClient.FindAccount(ctx, model.StorageAccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving Account %q for Table %q: %s", model.StorageAccountName, model.TableName, err)
+			}
+			if account == nil {
+				return fmt.Errorf("unable to locate Storage Account %q", model.StorageAccountName)
+			}
+
+			client, err := storageClient.TableEntityClient(ctx, *account)
+			if err != nil {
+				return fmt.Errorf("building Table Entity Client for Storage Account %q (Resource Group %q): %s", model.StorageAccountName, account.ResourceGroup, err)
+			}
+
+			id := parse.NewStorageTableEntitiesId(model.StorageAccountName, storageClient.Environment.StorageEndpointSuffix, model.TableName, model.Filter)
+
+			// only the keys are needed to delete each Entity
+			input := entities.QueryEntitiesInput{
+				Filter:                &model.Filter,
+				PropertyNamesToSelect: &[]string{"PartitionKey", "RowKey"},
+				MetaDataLevel:         entities.NoMetaData,
+			}
+			matches, err := queryStorageTableEntities(ctx, client, model.StorageAccountName, model.TableName, input, 0)
+			if err != nil {
+				return fmt.Errorf("retrieving Entities (Filter %q) (Table %q / Storage Account %q / Resource Group %q): %s", model.Filter, model.TableName, model.StorageAccountName, account.ResourceGroup, err)
+			}
+
+			model.MatchedCount = len(matches)
+			model.DeletedCount = 0
+			if model.DryRun {
+				log.Printf("[DEBUG] Dry Run: %d Entities (Table %q / Storage Account %q) match the Filter %q and would be deleted", model.MatchedCount, model.TableName, model.StorageAccountName, model.Filter)
+			} else {
+				batchClient, err := storageClient.TableBatchClient(ctx, *account)
+				if err != nil {
+					return fmt.Errorf("building Table Batch Client for Storage Account %q (Resource Group %q): %s", model.StorageAccountName, account.ResourceGroup, err)
+				}
+
+				// the Entities are deleted in batches grouped by Partition Key, however since a batch fails should
+				// any Entity within it have been removed since it was retrieved, that batch is then retried by
+				// deleting each Entity individually
+				for _, batch := range batchStorageTableEntities(matches, intStor.TableBatchMaxOperations) {
+					_, err := batchClient.DeleteEntities(ctx, model.StorageAccountName, model.TableName, batch)
+					if err == nil {
+						model.DeletedCount += len(batch)
+						continue
+					}
+					log.Printf("[DEBUG] Deleting the batch of %d Entities (Partition Key %q) failed - deleting these individually: %+v", len(batch), batch[0]["PartitionKey"], err)
+
+					for _, entity := range batch {
+						partitionKey, _ := entity["PartitionKey"].(string)
+						rowKey, _ := entity["RowKey"].(string)
+
+						deleteInput := entities.DeleteEntityInput{
+							PartitionKey: partitionKey,
+							RowKey:       rowKey,
+						}
+						if resp, err := client.Delete(ctx, model.StorageAccountName, model.TableName, deleteInput); err != nil {
+							if utils.ResponseWasNotFound(resp) {
+								continue
+							}
+							return fmt.Errorf("deleting Entity (Partition Key %q / Row Key %q) (Table %q / Storage Account %q / Resource Group %q) - %d of the %d matching Entities were deleted: %s", partitionKey, rowKey, model.TableName, model.StorageAccountName, account.ResourceGroup, model.DeletedCount, model.MatchedCount, err)
+						}
+						model.DeletedCount++
+					}
+				}
+				log.Printf("[DEBUG] Deleted %d of the %d Entities (Table %q / Storage Account %q) matching the Filter %q", model.DeletedCount, model.MatchedCount, model.TableName, model.StorageAccountName, model.Filter)
+			}
+
+			metadata.SetID(id)
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r StorageTableEntityCleanupResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.StorageTableEntitiesID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// the cleanup is performed once when this resource is created, so the counts recorded at that time are
+			// retained - this is only removed from the state when the Storage Account no longer exists
+			account, err := metadata.Client.Storage.FindAccount(ctx, id.AccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving Account %q for Table %q: %s", id.AccountName, id.TableName, err)
+			}
+			if account == nil {
+				log.Printf("[DEBUG] Unable to locate Account %q for Table %q - assuming removed & removing from state", id.AccountName, id.TableName)
+				return metadata.MarkAsGone(id)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageTableEntityCleanupResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// the Entities removed when this resource was created can't be restored, so this is only removed from the state
+			log.Printf("[DEBUG] Removing %s from the state - no Entities are changed", metadata.ResourceData.Id())
+			return nil
+		},
+	}
+}
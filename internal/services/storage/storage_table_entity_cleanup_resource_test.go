@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StorageTableEntityCleanupResource struct{}
+
+func TestAccStorageTableEntityCleanup_dryRun(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_table_entity_cleanup", "test")
+	r := StorageTableEntityCleanupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("matched_count").HasValue("2"),
+				check.That(data.ResourceName).Key("deleted_count").HasValue("0"),
+			),
+		},
+	})
+}
+
+func TestAccStorageTableEntityCleanup_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_table_entity_cleanup", "test")
+	r := StorageTableEntityCleanupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("matched_count").HasValue("2"),
+				check.That(data.ResourceName).Key("deleted_count").HasValue("2"),
+			),
+			// the stale Entities managed in this configuration have been deleted, so are planned to be recreated
+			ExpectNonEmptyPlan: true,
+		},
+	})
+}
+
+func (r StorageTableEntityCleanupResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.StorageTableEntitiesID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+	account, err := client.Storage.FindAccount(ctx, id.AccountName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Account %q for Table %q: %+v", id.AccountName, id.TableName, err)
+	}
+	return utils.Bool(account != nil), nil
+}
+
+func (r StorageTableEntityCleanupResource) basic(data acceptance.TestData, dryRun bool) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_table" "test" {
+  name                 = "acctestst%d"
+  storage_account_name = azurerm_storage_account.test.name
+}
+
+resource "azurerm_storage_table_entity" "stale" {
+  count = 2
+
+  storage_account_name = azurerm_storage_account.test.name
+  table_name           = azurerm_storage_table.test.name
+
+  partition_key = "stale"
+  row_key       = "row${count.index}"
+
+  entity = {
+    Foo = "Bar"
+  }
+}
+
+resource "azurerm_storage_table_entity" "current" {
+  storage_account_name = azurerm_storage_account.test.name
+  table_name           = azurerm_storage_table.test.name
+
+  partition_key = "current"
+  row_key       = "row0"
+
+  entity = {
+    Foo = "Bar"
+  }
+}
+
+resource "azurerm_storage_table_entity_cleanup" "test" {
+  storage_account_name = azurerm_storage_account.test.name
+  table_name           = azurerm_storage_table.test.name
+  filter               = "PartitionKey eq 'stale'"
+  dry_run              = %t
+
+  depends_on = [
+    azurerm_storage_table_entity.stale,
+    azurerm_storage_table_entity.current,
+  ]
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, data.RandomInteger, dryRun)
+}
@@ -0,0 +1,229 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	intStor "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/table/entities"
+)
+
+type StorageTableEntityCopyResource struct{}
+
+var _ sdk.Resource = StorageTableEntityCopyResource{}
+
+type StorageTableEntityCopyModel struct {
+	SourceStorageAccountName      string `tfschema:"source_storage_account_name"`
+	SourceTableName               string `tfschema:"source_table_name"`
+	DestinationStorageAccountName string `tfschema:"destination_storage_account_name"`
+	DestinationTableName          string `tfschema:"destination_table_name"`
+	Filter                        string `tfschema:"filter"`
+	CopiedCount                   int    `tfschema:"copied_count"`
+}
+
+func (r StorageTableEntityCopyResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"source_storage_account_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StorageAccountName,
+		},
+
+		"source_table_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StorageTableName,
+		},
+
+		"destination_storage_account_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StorageAccountName,
+		},
+
+		"destination_table_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StorageTableName,
+		},
+
+		"filter": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r StorageTableEntityCopyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"copied_count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+	}
+}
+
+func (r StorageTableEntityCopyResource) ModelObject() interface{} {
+	return &StorageTableEntityCopyModel{}
+}
+
+func (r StorageTableEntityCopyResource) ResourceType() string {
+	return "azurerm_storage_table_entity_copy"
+}
+
+func (r StorageTableEntityCopyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.StorageTableEntitiesID
+}
+
+func (r StorageTableEntityCopyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			var model StorageTableEntityCopyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if model.SourceStorageAccountName == model.DestinationStorageAccountName && model.SourceTableName == model.DestinationTableName {
+				return fmt.Errorf("the source and destination Table must be different")
+			}
+
+			sourceAccount, err := storageClient.FindAccount(ctx, model.SourceStorageAccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving Account %q for Table %q: %s", model.SourceStorageAccountName, model.SourceTableName, err)
+			}
+			if sourceAccount == nil {
+				return fmt.Errorf("unable to locate Storage Account %q", model.SourceStorageAccountName)
+			}
+
+			destinationAccount, err := storageClient.FindAccount(ctx, model.DestinationStorageAccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving Account %q for Table %q: %s", model.DestinationStorageAccountName, model.DestinationTableName, err)
+			}
+			if destinationAccount == nil {
+				return fmt.Errorf("unable to locate Storage Account %q", model.DestinationStorageAccountName)
+			}
+
+			sourceClient, err := storageClient.TableEntityClient(ctx, *sourceAccount)
+			if err != nil {
+				return fmt.Errorf("building Table Entity Client for Storage Account %q (Resource Group %q): %s", model.SourceStorageAccountName, sourceAccount.ResourceGroup, err)
+			}
+
+			destinationClient, err := storageClient.TableBatchClient(ctx, *destinationAccount)
+			if err != nil {
+				return fmt.Errorf("building Table Batch Client for Storage Account %q (Resource Group %q): %s", model.DestinationStorageAccountName, destinationAccount.ResourceGroup, err)
+			}
+
+			// the ID is scoped to the destination Table, with the source (and filter) making it unique
+			source := fmt.Sprintf("%s/%s/%s", model.SourceStorageAccountName, model.SourceTableName, model.Filter)
+			id := parse.NewStorageTableEntitiesId(model.DestinationStorageAccountName, storageClient.Environment.StorageEndpointSuffix, model.DestinationTableName, source)
+
+			// the full metadata is retrieved so that the type of each property is retained in the destination Table
+			input := entities.QueryEntitiesInput{
+				MetaDataLevel: entities.FullMetaData,
+			}
+			if model.Filter != "" {
+				input.Filter = &model.Filter
+			}
+			matches, err := queryStorageTableEntities(ctx, sourceClient, model.SourceStorageAccountName, model.SourceTableName, input, 0)
+			if err != nil {
+				return fmt.Errorf("retrieving Entities (Table %q / Storage Account %q / Resource Group %q): %s", model.SourceTableName, model.SourceStorageAccountName, sourceAccount.ResourceGroup, err)
+			}
+
+			model.CopiedCount = 0
+			for _, batch := range batchStorageTableEntities(matches, intStor.TableBatchMaxOperations) {
+				if _, err := destinationClient.InsertOrReplaceEntities(ctx, model.DestinationStorageAccountName, model.DestinationTableName, batch); err != nil {
+					return fmt.Errorf("copying Entities (Partition Key %q) to Table %q (Storage Account %q / Resource Group %q) - %d of the %d Entities were copied: %s", batch[0]["PartitionKey"], model.DestinationTableName, model.DestinationStorageAccountName, destinationAccount.ResourceGroup, model.CopiedCount, len(matches), err)
+				}
+				model.CopiedCount += len(batch)
+			}
+			log.Printf("[DEBUG] Copied %d Entities from Table %q (Storage Account %q) to Table %q (Storage Account %q)", model.CopiedCount, model.SourceTableName, model.SourceStorageAccountName, model.DestinationTableName, model.DestinationStorageAccountName)
+
+			metadata.SetID(id)
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r StorageTableEntityCopyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.StorageTableEntitiesID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// the copy is performed once when this resource is created, so the count recorded at that time is
+			// retained - this is only removed from the state when the destination Storage Account no longer exists
+			account, err := metadata.Client.Storage.FindAccount(ctx, id.AccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving Account %q for Table %q: %s", id.AccountName, id.TableName, err)
+			}
+			if account == nil {
+				log.Printf("[DEBUG] Unable to locate Account %q for Table %q - assuming removed & removing from state", id.AccountName, id.TableName)
+				return metadata.MarkAsGone(id)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageTableEntityCopyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// the copied Entities are left in place in the destination Table, so this is only removed from the state
+			log.Printf("[DEBUG] Removing %s from the state - no Entities are changed", metadata.ResourceData.Id())
+			return nil
+		},
+	}
+}
+
+// batchStorageTableEntities splits the specified Entities into batches of at most `size` Entities, where each batch
+// only contains Entities from a single Partition - as required for an Entity Group Transaction. The OData metadata
+// and Timestamp (which is assigned by the Table Service) are removed, leaving the property type annotations.
+func batchStorageTableEntities(input []map[string]interface{}, size int) [][]map[string]interface{} {
+	batches := make([][]map[string]interface{}, 0)
+	batch := make([]map[string]interface{}, 0)
+	for _, entity := range input {
+		partitionKey, _ := entity["PartitionKey"].(string)
+		if len(batch) > 0 && (len(batch) == size || batch[0]["PartitionKey"] != partitionKey) {
+			batches = append(batches, batch)
+			batch = make([]map[string]interface{}, 0)
+		}
+
+		output := make(map[string]interface{})
+		for k, v := range entity {
+			if strings.HasPrefix(k, "odata.") || k == "Timestamp" || k == "Timestamp@odata.type" {
+				continue
+			}
+			output[k] = v
+		}
+		batch = append(batch, output)
+	}
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+	return batches
+}
@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StorageTableEntityCopyResource struct{}
+
+func TestAccStorageTableEntityCopy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_table_entity_copy", "test")
+	r := StorageTableEntityCopyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, ""),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("copied_count").HasValue("3"),
+			),
+		},
+		{
+			Config: r.copied(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That("data.azurerm_storage_table_entity.copied").Key("entity.Foo").HasValue("Bar"),
+				check.That("data.azurerm_storage_table_entity.copied").Key("entity.Count").HasValue("42"),
+			),
+		},
+	})
+}
+
+func TestAccStorageTableEntityCopy_filter(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_table_entity_copy", "test")
+	r := StorageTableEntityCopyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "filter = \"PartitionKey eq 'partition1'\""),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("copied_count").HasValue("2"),
+			),
+		},
+	})
+}
+
+func (r StorageTableEntityCopyResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.StorageTableEntitiesID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+	account, err := client.Storage.FindAccount(ctx, id.AccountName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Account %q for Table %q: %+v", id.AccountName, id.TableName, err)
+	}
+	return utils.Bool(account != nil), nil
+}
+
+func (r StorageTableEntityCopyResource) basic(data acceptance.TestData, filter string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_table_entity_copy" "test" {
+  source_storage_account_name      = azurerm_storage_account.source.name
+  source_table_name                = azurerm_storage_table.source.name
+  destination_storage_account_name = azurerm_storage_account.destination.name
+  destination_table_name           = azurerm_storage_table.destination.name
+  %s
+
+  depends_on = [
+    azurerm_storage_table_entity.test,
+  ]
+}
+`, r.template(data), filter)
+}
+
+func (r StorageTableEntityCopyResource) copied(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_storage_table_entity" "copied" {
+  storage_account_name = azurerm_storage_account.destination.name
+  table_name           = azurerm_storage_table.destination.name
+  partition_key        = "partition1"
+  row_key              = "row0"
+}
+`, r.basic(data, ""))
+}
+
+func (r StorageTableEntityCopyResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "source" {
+  name                     = "acctestsrc%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_account" "destination" {
+  name                     = "acctestdst%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_table" "source" {
+  name                 = "acctestsrc%[1]d"
+  storage_account_name = azurerm_storage_account.source.name
+}
+
+resource "azurerm_storage_table" "destination" {
+  name                 = "acctestdst%[1]d"
+  storage_account_name = azurerm_storage_account.destination.name
+}
+
+locals {
+  entities = {
+    "partition1/row0" = { partition_key = "partition1", row_key = "row0" }
+    "partition1/row1" = { partition_key = "partition1", row_key = "row1" }
+    "partition2/row0" = { partition_key = "partition2", row_key = "row0" }
+  }
+}
+
+resource "azurerm_storage_table_entity" "test" {
+  for_each = local.entities
+
+  storage_account_name = azurerm_storage_account.source.name
+  table_name           = azurerm_storage_table.source.name
+
+  partition_key = each.value.partition_key
+  row_key       = each.value.row_key
+
+  entity = {
+    Foo   = "Bar"
+    Count = 42
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
@@ -10,8 +10,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
 	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/table/entities"
 )
 
@@ -39,13 +39,13 @@ func dataSourceStorageTableEntity() *pluginsdk.Resource {
 			"partition_key": {
 				Type:         pluginsdk.TypeString,
 				Required:     true,
-				ValidateFunc: validation.StringIsNotEmpty,
+				ValidateFunc: validate.StorageTableEntityKey,
 			},
 
 			"row_key": {
 				Type:         pluginsdk.TypeString,
 				Required:     true,
-				ValidateFunc: validation.StringIsNotEmpty,
+				ValidateFunc: validate.StorageTableEntityKey,
 			},
 
 			"entity": {
@@ -55,6 +55,16 @@ func dataSourceStorageTableEntity() *pluginsdk.Resource {
 					Type: pluginsdk.TypeString,
 				},
 			},
+
+			"etag": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"timestamp": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -92,16 +102,36 @@ func dataSourceStorageTableEntityRead(d *pluginsdk.ResourceData, meta interface{
 
 	result, err := client.Get(ctx, storageAccountName, tableName, input)
 	if err != nil {
+		if utils.ResponseWasNotFound(result.Response) {
+			return fmt.Errorf("the Entity (Partition Key %q / Row Key %q) was not found in Table %q / Storage Account %q", partitionKey, rowKey, tableName, storageAccountName)
+		}
 		return fmt.Errorf("retrieving Entity (Partition Key %q / Row Key %q) (Table %q / Storage Account %q / Resource Group %q): %s", partitionKey, rowKey, tableName, storageAccountName, account.ResourceGroup, err)
 	}
 
+	// the Timestamp is removed from the Entity when it's flattened, so this needs to be retrieved first
+	timestamp := ""
+	if v, ok := result.Entity["Timestamp"].(string); ok {
+		timestamp = v
+	}
+	etag := ""
+	if result.Response.Response != nil {
+		etag = result.Response.Header.Get("ETag")
+	}
+
 	d.Set("storage_account_name", storageAccountName)
 	d.Set("table_name", tableName)
 	d.Set("partition_key", partitionKey)
 	d.Set("row_key", rowKey)
-	if err := d.Set("entity", flattenEntity(result.Entity)); err != nil {
+	// properties of an unsupported type are omitted, since these can't be ignored for a Data Source
+	entity, err := flattenEntity(result.Entity, false)
+	if err != nil {
+		return fmt.Errorf("flattening Entity (Partition Key %q / Row Key %q) (Table %q / Storage Account %q / Resource Group %q): %s", partitionKey, rowKey, tableName, storageAccountName, account.ResourceGroup, err)
+	}
+	if err := d.Set("entity", entity); err != nil {
 		return fmt.Errorf("setting `entity` for Entity (Partition Key %q / Row Key %q) (Table %q / Storage Account %q / Resource Group %q): %s", partitionKey, rowKey, tableName, storageAccountName, account.ResourceGroup, err)
 	}
+	d.Set("etag", etag)
+	d.Set("timestamp", timestamp)
 	d.SetId(id)
 
 	return nil
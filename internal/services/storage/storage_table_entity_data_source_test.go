@@ -5,6 +5,7 @@ package storage_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -22,11 +23,24 @@ func TestAccDataSourceStorageTableEntity_basic(t *testing.T) {
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).Key("entity.%").HasValue("1"),
 				check.That(data.ResourceName).Key("entity.testkey").HasValue("testval"),
+				check.That(data.ResourceName).Key("etag").IsSet(),
+				check.That(data.ResourceName).Key("timestamp").IsSet(),
 			),
 		},
 	})
 }
 
+func TestAccDataSourceStorageTableEntity_notFound(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_table_entity", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config:      StorageTableEntityDataSource{}.notFound(data),
+			ExpectError: regexp.MustCompile("was not found"),
+		},
+	})
+}
+
 func (d StorageTableEntityDataSource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -79,3 +93,17 @@ data "azurerm_storage_table_entity" "test" {
 }
 `, config)
 }
+
+func (d StorageTableEntityDataSource) notFound(data acceptance.TestData) string {
+	config := d.basic(data)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_storage_table_entity" "test" {
+  table_name           = azurerm_storage_table_entity.test.table_name
+  storage_account_name = azurerm_storage_table_entity.test.storage_account_name
+  partition_key        = azurerm_storage_table_entity.test.partition_key
+  row_key              = "doesnotexist"
+}
+`, config)
+}
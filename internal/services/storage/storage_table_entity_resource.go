@@ -4,13 +4,20 @@
 package storage
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"net/http"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	intStor "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -27,7 +34,7 @@ func resourceStorageTableEntity() *pluginsdk.Resource {
 		Delete: resourceStorageTableEntityDelete,
 
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := entities.ParseResourceID(id)
+			_, err := parse.StorageTableEntityDataPlaneID(id)
 			return err
 		}),
 
@@ -55,13 +62,13 @@ func resourceStorageTableEntity() *pluginsdk.Resource {
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validation.StringIsNotEmpty,
+				ValidateFunc: validate.StorageTableEntityKey,
 			},
 			"row_key": {
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validation.StringIsNotEmpty,
+				ValidateFunc: validate.StorageTableEntityKey,
 			},
 			"entity": {
 				Type:     pluginsdk.TypeMap,
@@ -70,10 +77,94 @@ func resourceStorageTableEntity() *pluginsdk.Resource {
 					Type: pluginsdk.TypeString,
 				},
 			},
+			"entity_types": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"Edm.Boolean",
+						"Edm.Double",
+						"Edm.Int32",
+						"Edm.Int64",
+						"Edm.String",
+					}, false),
+				},
+			},
+			"ignore_properties": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+			"allow_empty_entity": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"create_table_if_missing": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"error_on_unsupported_property_types": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"if_match_etag": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.StorageTableEntityETag,
+			},
+			"table_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceStorageTableEntityCustomizeDiff),
 	}
 }
 
+func resourceStorageTableEntityCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+	entity := diff.Get("entity").(map[string]interface{})
+
+	// when `entity` references a value which isn't known until apply (e.g. an attribute of another resource) it's
+	// empty during the plan, as such the checks against its properties are only made once it's known
+	if !diff.NewValueKnown("entity") {
+		return nil
+	}
+
+	// an empty `entity` is more commonly a mistake (e.g. an empty variable) than an intentional key-only Entity
+	if len(entity) == 0 && !diff.Get("allow_empty_entity").(bool) {
+		return fmt.Errorf("`entity` must contain at least one property - to create an Entity containing only the `partition_key` and `row_key` set `allow_empty_entity` to `true`")
+	}
+
+	for k := range diff.Get("entity_types").(map[string]interface{}) {
+		if _, ok := entity[k]; !ok {
+			return fmt.Errorf("the property %q is specified in `entity_types` but isn't present in `entity`", k)
+		}
+		if _, ok := entity[k+"@odata.type"]; ok {
+			return fmt.Errorf("the type of the property %q must be specified in either `entity_types` or `entity` (as %q) but not both", k, k+"@odata.type")
+		}
+	}
+
+	for _, raw := range diff.Get("ignore_properties").(*pluginsdk.Set).List() {
+		k := raw.(string)
+		if _, ok := entity[k]; ok {
+			return fmt.Errorf("the property %q is specified in `ignore_properties` and so cannot be managed within `entity`", k)
+		}
+		if _, ok := entity[k+"@odata.type"]; ok {
+			return fmt.Errorf("the property %q is specified in `ignore_properties` and so its type cannot be managed within `entity`", k)
+		}
+	}
+
+	return nil
+}
+
 func resourceStorageTableEntityCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -83,7 +174,7 @@ func resourceStorageTableEntityCreateUpdate(d *pluginsdk.ResourceData, meta inte
 	tableName := d.Get("table_name").(string)
 	partitionKey := d.Get("partition_key").(string)
 	rowKey := d.Get("row_key").(string)
-	entity := d.Get("entity").(map[string]interface{})
+	entity := expandStorageTableEntity(d.Get("entity").(map[string]interface{}), d.Get("entity_types").(map[string]interface{}))
 
 	account, err := storageClient.FindAccount(ctx, accountName)
 	if err != nil {
@@ -104,47 +195,186 @@ func resourceStorageTableEntityCreateUpdate(d *pluginsdk.ResourceData, meta inte
 		return fmt.Errorf("building Entity Client: %s", err)
 	}
 
-	if d.IsNewResource() {
-		input := entities.GetEntityInput{
+	host, err := account.DataPlaneHost(intStor.EndpointTypeTable)
+	if err != nil {
+		return err
+	}
+	resourceId := parse.NewStorageTableEntityDataPlaneId(accountName, host.DomainSuffix, tableName, partitionKey, rowKey)
+	resourceId.DnsZone = host.DnsZone
+
+	// the ETag of the Entity changes each time this is written (including by Terraform), so `if_match_etag` is only
+	// asserted when the Entity is created or when the ETag itself is changed - otherwise this would never match
+	ifMatchETag := ""
+	if d.IsNewResource() || d.HasChange("if_match_etag") {
+		ifMatchETag = d.Get("if_match_etag").(string)
+	}
+
+	// InsertOrMerge never removes properties from an Entity, so when properties have been removed
+	// from the configuration we need to replace the Entity for these to be removed
+	removedProperties := make([]string, 0)
+	if !d.IsNewResource() && d.HasChange("entity") {
+		oldRaw, newRaw := d.GetChange("entity")
+		removedProperties = removedStorageTableEntityProperties(oldRaw.(map[string]interface{}), newRaw.(map[string]interface{}))
+	}
+
+	if d.IsNewResource() && d.Get("create_table_if_missing").(bool) {
+		tablesClient, err := storageClient.TablesClient(ctx, *account)
+		if err != nil {
+			return fmt.Errorf("building Table Client: %s", err)
+		}
+
+		exists, err := tablesClient.Exists(ctx, account.ResourceGroup, accountName, tableName)
+		if err != nil {
+			return fmt.Errorf("checking for the presence of Table %q (Storage Account %q / Resource Group %q): %s", tableName, accountName, account.ResourceGroup, err)
+		}
+		if exists == nil || !*exists {
+			log.Printf("[DEBUG] Creating Table %q (Storage Account %q) since it doesn't exist", tableName, accountName)
+			if err := tablesClient.Create(ctx, account.ResourceGroup, accountName, tableName); err != nil {
+				// another Entity may have created the Table in the interim, in which case that's fine
+				exists, existsErr := tablesClient.Exists(ctx, account.ResourceGroup, accountName, tableName)
+				if existsErr != nil || exists == nil || !*exists {
+					return fmt.Errorf("creating Table %q (Storage Account %q / Resource Group %q): %s", tableName, accountName, account.ResourceGroup, err)
+				}
+			}
+		}
+	}
+
+	if d.IsNewResource() && ifMatchETag == "" {
+		// Insert fails with a 409 Conflict when the Entity already exists, which (unlike checking for the
+		// Entity prior to creating it) means we can't overwrite an Entity created in the interim
+		input := entities.InsertEntityInput{
 			PartitionKey:  partitionKey,
 			RowKey:        rowKey,
+			Entity:        entity,
 			MetaDataLevel: entities.NoMetaData,
 		}
-		existing, err := client.Get(ctx, accountName, tableName, input)
-		if err != nil {
-			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("checking for presence of existing Entity (Partition Key %q / Row Key %q) (Table %q / Storage Account %q / Resource Group %q): %s", partitionKey, rowKey, tableName, accountName, account.ResourceGroup, err)
+
+		if resp, err := client.Insert(ctx, accountName, tableName, input); err != nil {
+			// no response is returned for a transport-level error (e.g. a dropped connection), which is surfaced
+			// as-is rather than being treated as the Entity already existing
+			if utils.ResponseWasConflict(resp) {
+				return tf.ImportAsExistsError("azurerm_storage_table_entity", resourceId.ID())
 			}
+			if utils.ResponseWasNotFound(resp) {
+				return storageTableEntityTableNotFoundError(tableName, accountName, account.ResourceGroup)
+			}
+			return fmt.Errorf("creating Entity (Partition Key %q / Row Key %q) (Table %q / Storage Account %q / Resource Group %q): %+v", partitionKey, rowKey, tableName, accountName, account.ResourceGroup, err)
 		}
+	} else if len(removedProperties) > 0 {
+		log.Printf("[DEBUG] Replacing Entity (Partition Key %q / Row Key %q) (Table %q / Storage Account %q) to remove the properties %q", partitionKey, rowKey, tableName, accountName, strings.Join(removedProperties, ", "))
+		// since replacing the Entity removes any properties not specified, we need to retain the current
+		// values of the properties which are ignored, as these are managed outside of Terraform
+		if ignoredProperties := d.Get("ignore_properties").(*pluginsdk.Set).List(); len(ignoredProperties) > 0 {
+			getInput := entities.GetEntityInput{
+				PartitionKey:  partitionKey,
+				RowKey:        rowKey,
+				MetaDataLevel: entities.MinimalMetaData,
+			}
+			existing, err := client.Get(ctx, accountName, tableName, getInput)
+			if err != nil {
+				return fmt.Errorf("retrieving Entity (Partition Key %q / Row Key %q) (Table %q / Storage Account %q / Resource Group %q): %+v", partitionKey, rowKey, tableName, accountName, account.ResourceGroup, err)
+			}
 
-		if !utils.ResponseWasNotFound(existing.Response) {
-			id := client.GetResourceID(accountName, tableName, partitionKey, rowKey)
-			return tf.ImportAsExistsError("azurerm_storage_table_entity", id)
+			for _, raw := range ignoredProperties {
+				k := raw.(string)
+				if v, ok := existing.Entity[k]; ok {
+					entity[k] = v
+				}
+				if v, ok := existing.Entity[k+"@odata.type"]; ok {
+					entity[k+"@odata.type"] = v
+				}
+			}
 		}
-	}
 
-	input := entities.InsertOrMergeEntityInput{
-		PartitionKey: partitionKey,
-		RowKey:       rowKey,
-		Entity:       entity,
-	}
+		if ifMatchETag != "" {
+			conditionalClient, err := storageClient.TableEntityConditionalClient(ctx, *account)
+			if err != nil {
+				return fmt.Errorf("building Conditional Entity Client: %s", err)
+			}
+
+			input := intStor.ConditionalEntityInput{
+				PartitionKey: partitionKey,
+				RowKey:       rowKey,
+				IfMatch:      ifMatchETag,
+				Entity:       entity,
+			}
+			if resp, err := conditionalClient.Update(ctx, accountName, tableName, input); err != nil {
+				return storageTableEntityIfMatchError(resp, err, "replacing", ifMatchETag, partitionKey, rowKey, tableName, accountName, account.ResourceGroup)
+			}
+		} else {
+			input := entities.InsertOrReplaceEntityInput{
+				PartitionKey: partitionKey,
+				RowKey:       rowKey,
+				Entity:       entity,
+			}
 
-	if _, err := client.InsertOrMerge(ctx, accountName, tableName, input); err != nil {
-		return fmt.Errorf("creating Entity (Partition Key %q / Row Key %q) (Table %q / Storage Account %q / Resource Group %q): %+v", partitionKey, rowKey, tableName, accountName, account.ResourceGroup, err)
+			if resp, err := client.InsertOrReplace(ctx, accountName, tableName, input); err != nil {
+				if utils.ResponseWasNotFound(resp) {
+					return storageTableEntityTableNotFoundError(tableName, accountName, account.ResourceGroup)
+				}
+				return fmt.Errorf("replacing Entity (Partition Key %q / Row Key %q) (Table %q / Storage Account %q / Resource Group %q): %+v", partitionKey, rowKey, tableName, accountName, account.ResourceGroup, err)
+			}
+		}
+	} else if ifMatchETag != "" {
+		conditionalClient, err := storageClient.TableEntityConditionalClient(ctx, *account)
+		if err != nil {
+			return fmt.Errorf("building Conditional Entity Client: %s", err)
+		}
+
+		input := intStor.ConditionalEntityInput{
+			PartitionKey: partitionKey,
+			RowKey:       rowKey,
+			IfMatch:      ifMatchETag,
+			Entity:       entity,
+		}
+		if resp, err := conditionalClient.Merge(ctx, accountName, tableName, input); err != nil {
+			return storageTableEntityIfMatchError(resp, err, "updating", ifMatchETag, partitionKey, rowKey, tableName, accountName, account.ResourceGroup)
+		}
+	} else {
+		input := entities.InsertOrMergeEntityInput{
+			PartitionKey: partitionKey,
+			RowKey:       rowKey,
+			Entity:       entity,
+		}
+
+		if resp, err := client.InsertOrMerge(ctx, accountName, tableName, input); err != nil {
+			if utils.ResponseWasNotFound(resp) {
+				return storageTableEntityTableNotFoundError(tableName, accountName, account.ResourceGroup)
+			}
+			return fmt.Errorf("updating Entity (Partition Key %q / Row Key %q) (Table %q / Storage Account %q / Resource Group %q): %+v", partitionKey, rowKey, tableName, accountName, account.ResourceGroup, err)
+		}
 	}
 
-	resourceID := client.GetResourceID(accountName, tableName, partitionKey, rowKey)
-	d.SetId(resourceID)
+	d.SetId(resourceId.ID())
 
 	return resourceStorageTableEntityRead(d, meta)
 }
 
+// storageTableEntityTableNotFoundError returns the error used when the Table containing an Entity doesn't exist,
+// since the Table Service returns a 404 for the Entity without making this clear
+func storageTableEntityTableNotFoundError(tableName, accountName, resourceGroup string) error {
+	return fmt.Errorf("Table %q does not exist in Storage Account %q (Resource Group %q) - either create the Table first (for example using `azurerm_storage_table`) or set `create_table_if_missing` to `true`", tableName, accountName, resourceGroup)
+}
+
+// storageTableEntityIfMatchError returns the error used when writing an Entity using `if_match_etag` fails - since
+// this requires the Entity to exist and to not have been modified since the ETag was retrieved, these are surfaced
+// explicitly rather than as the error returned from the Table Service
+func storageTableEntityIfMatchError(resp autorest.Response, err error, action, ifMatchETag, partitionKey, rowKey, tableName, accountName, resourceGroup string) error {
+	if utils.ResponseWasStatusCode(resp, http.StatusPreconditionFailed) {
+		return fmt.Errorf("%s Entity (Partition Key %q / Row Key %q) (Table %q / Storage Account %q / Resource Group %q): the Entity has been modified since the ETag %q specified in `if_match_etag` was retrieved (412 Precondition Failed) - retrieve the current ETag of the Entity and try again", action, partitionKey, rowKey, tableName, accountName, resourceGroup, ifMatchETag)
+	}
+	if utils.ResponseWasNotFound(resp) {
+		return fmt.Errorf("%s Entity (Partition Key %q / Row Key %q) (Table %q / Storage Account %q / Resource Group %q): either the Entity or the Table doesn't exist - `if_match_etag` can only be used to update an existing Entity, remove it to create the Entity", action, partitionKey, rowKey, tableName, accountName, resourceGroup)
+	}
+	return fmt.Errorf("%s Entity (Partition Key %q / Row Key %q) (Table %q / Storage Account %q / Resource Group %q): %+v", action, partitionKey, rowKey, tableName, accountName, resourceGroup, err)
+}
+
 func resourceStorageTableEntityRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	storageClient := meta.(*clients.Client).Storage
 
-	id, err := entities.ParseResourceID(d.Id())
+	id, err := parse.StorageTableEntityDataPlaneID(d.Id())
 	if err != nil {
 		return err
 	}
@@ -172,16 +402,62 @@ func resourceStorageTableEntityRead(d *pluginsdk.ResourceData, meta interface{})
 
 	result, err := client.Get(ctx, id.AccountName, id.TableName, input)
 	if err != nil {
+		if utils.ResponseWasNotFound(result.Response) {
+			log.Printf("[DEBUG] Entity (Partition Key %q / Row Key %q) (Table %q / Storage Account %q) was not found - removing from state", id.PartitionKey, id.RowKey, id.TableName, id.AccountName)
+			d.SetId("")
+			return nil
+		}
 		return fmt.Errorf("retrieving Entity (Partition Key %q / Row Key %q) (Table %q / Storage Account %q / Resource Group %q): %s", id.PartitionKey, id.RowKey, id.TableName, id.AccountName, account.ResourceGroup, err)
 	}
 
+	// the host within the ID differs from that of the Storage Account's endpoint when the state has been moved between
+	// clouds, or when the ID was built without the DNS Zone used by the Storage Account - since the Entity exists the ID
+	// is updated, rather than the Entity needing to be recreated
+	host, err := account.DataPlaneHost(intStor.EndpointTypeTable)
+	if err != nil {
+		return err
+	}
+	if id.DomainSuffix != host.DomainSuffix || id.DnsZone != host.DnsZone {
+		newId := *id
+		newId.DomainSuffix = host.DomainSuffix
+		newId.DnsZone = host.DnsZone
+		log.Printf("[DEBUG] Updating the ID for %s to use the Domain Suffix %q and DNS Zone %q", id, host.DomainSuffix, host.DnsZone)
+		d.SetId(newId.ID())
+	}
+
 	d.Set("storage_account_name", id.AccountName)
 	d.Set("table_name", id.TableName)
 	d.Set("partition_key", id.PartitionKey)
 	d.Set("row_key", id.RowKey)
-	if err := d.Set("entity", flattenEntity(result.Entity)); err != nil {
+
+	// the Table Endpoint is resolved from the Storage Account rather than built from the account name, so that
+	// this is correct for sovereign clouds and accounts using an Azure DNS Zone endpoint
+	tableEndpoint, err := account.DataPlaneEndpoint(intStor.EndpointTypeTable)
+	if err != nil {
+		return fmt.Errorf("retrieving the Table Endpoint for Storage Account %q (Resource Group %q): %s", id.AccountName, account.ResourceGroup, err)
+	}
+	d.Set("table_endpoint", tableEndpoint)
+
+	// the properties which are ignored are managed outside of Terraform, so shouldn't be tracked in the state - these
+	// are removed prior to flattening so that these can be used to ignore a property of an unsupported type
+	for _, raw := range d.Get("ignore_properties").(*pluginsdk.Set).List() {
+		k := raw.(string)
+		delete(result.Entity, k)
+		delete(result.Entity, k+"@odata.type")
+	}
+
+	flattened, err := flattenEntity(result.Entity, d.Get("error_on_unsupported_property_types").(bool))
+	if err != nil {
+		return fmt.Errorf("flattening Entity (Partition Key %q / Row Key %q) (Table %q / Storage Account %q / Resource Group %q): %s", id.PartitionKey, id.RowKey, id.TableName, id.AccountName, account.ResourceGroup, err)
+	}
+	entity, entityTypes := flattenStorageTableEntityTypes(flattened, d.Get("entity_types").(map[string]interface{}))
+
+	if err := d.Set("entity", entity); err != nil {
 		return fmt.Errorf("setting `entity` for Entity (Partition Key %q / Row Key %q) (Table %q / Storage Account %q / Resource Group %q): %s", id.PartitionKey, id.RowKey, id.TableName, id.AccountName, account.ResourceGroup, err)
 	}
+	if err := d.Set("entity_types", entityTypes); err != nil {
+		return fmt.Errorf("setting `entity_types` for Entity (Partition Key %q / Row Key %q) (Table %q / Storage Account %q / Resource Group %q): %s", id.PartitionKey, id.RowKey, id.TableName, id.AccountName, account.ResourceGroup, err)
+	}
 
 	return nil
 }
@@ -191,7 +467,7 @@ func resourceStorageTableEntityDelete(d *pluginsdk.ResourceData, meta interface{
 	defer cancel()
 	storageClient := meta.(*clients.Client).Storage
 
-	id, err := entities.ParseResourceID(d.Id())
+	id, err := parse.StorageTableEntityDataPlaneID(d.Id())
 	if err != nil {
 		return err
 	}
@@ -214,15 +490,70 @@ func resourceStorageTableEntityDelete(d *pluginsdk.ResourceData, meta interface{
 		RowKey:       id.RowKey,
 	}
 
-	if _, err := client.Delete(ctx, id.AccountName, id.TableName, input); err != nil {
+	if resp, err := client.Delete(ctx, id.AccountName, id.TableName, input); err != nil {
+		// the Table containing this Entity may have been deleted concurrently, in which case the Entity is gone too
+		if utils.ResponseWasNotFound(resp) {
+			log.Printf("[DEBUG] Entity (Partition Key %q / Row Key %q) (Table %q / Storage Account %q) was not found - assuming removed", id.PartitionKey, id.RowKey, id.TableName, id.AccountName)
+			return nil
+		}
 		return fmt.Errorf("deleting Entity (Partition Key %q / Row Key %q) (Table %q / Storage Account %q / Resource Group %q): %s", id.PartitionKey, id.RowKey, id.TableName, id.AccountName, account.ResourceGroup, err)
 	}
 
 	return nil
 }
 
+// removedStorageTableEntityProperties returns the (sorted) names of the properties which are present in `old` but not `new`
+func removedStorageTableEntityProperties(old, new map[string]interface{}) []string {
+	removed := make([]string, 0)
+	for k := range old {
+		if strings.HasSuffix(k, "@odata.type") {
+			continue
+		}
+		if _, ok := new[k]; !ok {
+			removed = append(removed, k)
+		}
+	}
+	sort.Strings(removed)
+	return removed
+}
+
+// expandStorageTableEntity returns the Entity with the `@odata.type` annotations for the types declared in `entityTypes`
+func expandStorageTableEntity(entity map[string]interface{}, entityTypes map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	for k, v := range entity {
+		result[k] = v
+	}
+	for k, v := range entityTypes {
+		result[k+"@odata.type"] = v
+	}
+	return result
+}
+
+// flattenStorageTableEntityTypes moves the `@odata.type` annotations for the properties declared in `entityTypes` out of
+// the (flattened) Entity - returning the types read from the API, so that any which don't match the declared type are surfaced
+func flattenStorageTableEntityTypes(entity map[string]interface{}, entityTypes map[string]interface{}) (map[string]interface{}, map[string]interface{}) {
+	types := make(map[string]interface{})
+	for k := range entityTypes {
+		if _, ok := entity[k]; !ok {
+			continue
+		}
+
+		// String properties aren't annotated with their type
+		dtype := "Edm.String"
+		if v, ok := entity[k+"@odata.type"]; ok {
+			dtype = fmt.Sprint(v)
+			delete(entity, k+"@odata.type")
+		}
+		types[k] = dtype
+	}
+
+	return entity, types
+}
+
 // The api returns extra information that we already have. We'll remove it here before setting it in state.
-func flattenEntity(entity map[string]interface{}) map[string]interface{} {
+// Properties of a type which isn't supported are skipped with a warning - unless `errorOnUnsupportedTypes` is set,
+// in which case an error is returned, since otherwise these properties are silently missing from the state.
+func flattenEntity(entity map[string]interface{}, errorOnUnsupportedTypes bool) (map[string]interface{}, error) {
 	delete(entity, "PartitionKey")
 	delete(entity, "RowKey")
 	delete(entity, "Timestamp")
@@ -238,13 +569,16 @@ func flattenEntity(entity map[string]interface{}) map[string]interface{} {
 			case "Edm.Boolean":
 				result[k] = fmt.Sprint(v)
 			case "Edm.Double":
-				result[k] = fmt.Sprintf("%f", v)
+				result[k] = formatStorageTableEntityDouble(v)
 			case "Edm.Int32", "Edm.Int64":
 				// `v` returned as string for int 64
 				result[k] = fmt.Sprint(v)
 			case "Edm.String":
 				result[k] = v
 			default:
+				if errorOnUnsupportedTypes {
+					return nil, storageTableEntityUnsupportedPropertyTypeError(k, fmt.Sprint(dtype))
+				}
 				log.Printf("[WARN] key %q with unexpected @odata.type %q", k, dtype)
 				continue
 			}
@@ -263,17 +597,36 @@ func flattenEntity(entity map[string]interface{}) map[string]interface{} {
 					result[k] = fmt.Sprintf("%d", int64(f64))
 					result[k+"@odata.type"] = "Edm.Int32"
 				} else {
-					// fmt.Sprintf("%f", v) will return `123.123000` for `123.123`, have to use fmt.Sprint
-					result[k] = fmt.Sprint(v)
+					result[k] = formatStorageTableEntityDouble(f64)
 					result[k+"@odata.type"] = "Edm.Double"
 				}
 			case string:
 				result[k] = v
 			default:
+				if errorOnUnsupportedTypes {
+					return nil, storageTableEntityUnsupportedPropertyTypeError(k, fmt.Sprintf("%T", c))
+				}
 				log.Printf("[WARN] key %q with unexpected type %T", k, c)
 			}
 		}
 	}
 
-	return result
+	return result, nil
+}
+
+// storageTableEntityUnsupportedPropertyTypeError returns an error explaining how a property of a type which isn't
+// supported (e.g. `Edm.DateTime` or `Edm.Guid`) can be handled, rather than this being omitted from the state
+func storageTableEntityUnsupportedPropertyTypeError(name, dtype string) error {
+	return fmt.Errorf("the property %q has the unsupported type %q - add this property to `ignore_properties` to manage this outside of Terraform, or set `error_on_unsupported_property_types` to `false` to omit this property", name, dtype)
+}
+
+// formatStorageTableEntityDouble returns the minimal decimal representation of an Edm.Double, so that this matches
+// the value specified in the config - fmt.Sprintf("%f", v) returns `123.450000` for `123.45`, whereas exponent
+// formatting (as used by fmt.Sprint) returns `1e+06` for `1000000.5` - either of which would cause a diff.
+// Values which aren't returned as a number (e.g. `NaN` or `Infinity`) are returned as-is.
+func formatStorageTableEntityDouble(v interface{}) string {
+	if f64, ok := v.(float64); ok {
+		return strconv.FormatFloat(f64, 'f', -1, 64)
+	}
+	return fmt.Sprint(v)
 }
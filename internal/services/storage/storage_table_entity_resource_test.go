@@ -6,11 +6,13 @@ package storage_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/table/entities"
@@ -27,6 +29,8 @@ func TestAccTableEntity_basic(t *testing.T) {
 			Config: r.basic(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				// the endpoint returned from Azure is normalized to remove the trailing slash
+				check.That(data.ResourceName).Key("table_endpoint").MatchesRegex(regexp.MustCompile(fmt.Sprintf(`^https://acctestsa%s\.table\.[^/]+[^/]$`, data.RandomString))),
 			),
 		},
 		data.ImportStep(),
@@ -70,6 +74,58 @@ func TestAccTableEntity_update(t *testing.T) {
 	})
 }
 
+func TestAccTableEntity_removeProperty(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_table_entity", "test")
+	r := StorageTableEntityResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("entity.%").HasValue("2"),
+				check.That(data.ResourceName).Key("entity.Test").DoesNotExist(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccTableEntity_outOfBandPropertiesAreRemoved(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_table_entity", "test")
+	r := StorageTableEntityResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			// a property added outside of Terraform is read into the state, and so shows as being removed in the plan
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.mergePropertiesOutOfBand(map[string]interface{}{
+					"Drift": "Value",
+				})),
+			),
+			ExpectNonEmptyPlan: true,
+		},
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("entity.%").HasValue("1"),
+				check.That(data.ResourceName).Key("entity.Drift").DoesNotExist(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccTableEntity_update_typed(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_storage_table_entity", "test")
 	r := StorageTableEntityResource{}
@@ -115,8 +171,159 @@ func TestAccTableEntity_update_typed(t *testing.T) {
 	})
 }
 
+func TestAccTableEntity_doubles(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_table_entity", "test")
+	r := StorageTableEntityResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.doubles(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("entity.Simple").HasValue("123.45"),
+				check.That(data.ResourceName).Key("entity.Negative").HasValue("-3.14159"),
+				check.That(data.ResourceName).Key("entity.Large").HasValue("1234567.5"),
+				check.That(data.ResourceName).Key("entity.Small").HasValue("0.00001"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccTableEntity_entityTypes(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_table_entity", "test")
+	r := StorageTableEntityResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.entityTypes(data, "Edm.Int32"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("entity_types.Foo").HasValue("Edm.Int32"),
+			),
+		},
+		{
+			Config: r.entityTypes(data, "Edm.Int64"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("entity_types.Foo").HasValue("Edm.Int64"),
+			),
+		},
+	})
+}
+
+func TestAccTableEntity_entityTypesUnknownEntity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_table_entity", "test")
+	r := StorageTableEntityResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.entityTypesUnknownEntity(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("entity_types.Test").HasValue("Edm.String"),
+			),
+		},
+	})
+}
+
+func TestAccTableEntity_ignoreProperties(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_table_entity", "test")
+	r := StorageTableEntityResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config: r.ignoreProperties(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("entity.%").HasValue("1"),
+				check.That(data.ResourceName).Key("entity.Foo").HasValue("Bar"),
+			),
+		},
+	})
+}
+
+func TestAccTableEntity_emptyEntity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_table_entity", "test")
+	r := StorageTableEntityResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.emptyEntity(data, false),
+			ExpectError: regexp.MustCompile("`entity` must contain at least one property"),
+		},
+		{
+			Config: r.emptyEntity(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("entity.%").HasValue("0"),
+			),
+		},
+		data.ImportStep("allow_empty_entity"),
+	})
+}
+
+func TestAccStorageTableEntity_createTableIfMissing(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_table_entity", "test")
+	r := StorageTableEntityResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.missingTable(data, false),
+			ExpectError: regexp.MustCompile("Table \"acctestmissing[0-9]+\" does not exist"),
+		},
+		{
+			Config: r.missingTable(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("create_table_if_missing"),
+	})
+}
+
+func TestAccStorageTableEntity_errorOnUnsupportedPropertyTypes(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_table_entity", "test")
+	r := StorageTableEntityResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.errorOnUnsupportedPropertyTypes(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("error_on_unsupported_property_types"),
+	})
+}
+
+func TestAccTableEntity_ifMatchETag(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_table_entity", "test")
+	r := StorageTableEntityResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.ifMatchETag(data, ""),
+			ExpectError: regexp.MustCompile("`if_match_etag` can only be used to update an existing Entity"),
+		},
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			// the ETag predates the Entity being created, so this can never match
+			Config:      r.ifMatchETag(data, r.basic(data)),
+			ExpectError: regexp.MustCompile(`412 Precondition Failed`),
+		},
+	})
+}
+
 func (r StorageTableEntityResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
-	id, err := entities.ParseResourceID(state.ID)
+	id, err := parse.StorageTableEntityDataPlaneID(state.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -148,6 +355,35 @@ func (r StorageTableEntityResource) Exists(ctx context.Context, client *clients.
 	return utils.Bool(true), nil
 }
 
+func (r StorageTableEntityResource) mergePropertiesOutOfBand(properties map[string]interface{}) acceptance.ClientCheckFunc {
+	return func(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) error {
+		id, err := parse.StorageTableEntityDataPlaneID(state.ID)
+		if err != nil {
+			return err
+		}
+		account, err := client.Storage.FindAccount(ctx, id.AccountName)
+		if err != nil {
+			return fmt.Errorf("retrieving Account %q for Table %q: %+v", id.AccountName, id.TableName, err)
+		}
+		if account == nil {
+			return fmt.Errorf("unable to locate Storage Account %q", id.AccountName)
+		}
+		entitiesClient, err := client.Storage.TableEntityClient(ctx, *account)
+		if err != nil {
+			return fmt.Errorf("building Table Entity Client: %+v", err)
+		}
+		input := entities.InsertOrMergeEntityInput{
+			PartitionKey: id.PartitionKey,
+			RowKey:       id.RowKey,
+			Entity:       properties,
+		}
+		if _, err := entitiesClient.InsertOrMerge(ctx, id.AccountName, id.TableName, input); err != nil {
+			return fmt.Errorf("merging properties into Entity (Partition Key %q / Row Key %q) (Table %q / Storage Account %q): %+v", id.PartitionKey, id.RowKey, id.TableName, id.AccountName, err)
+		}
+		return nil
+	}
+}
+
 func (r StorageTableEntityResource) basic(data acceptance.TestData) string {
 	template := r.template(data)
 	return fmt.Sprintf(`
@@ -306,6 +542,179 @@ resource "azurerm_storage_table_entity" "test" {
 `, template, data.RandomInteger, data.RandomInteger)
 }
 
+func (r StorageTableEntityResource) doubles(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_table_entity" "test" {
+  storage_account_name = azurerm_storage_account.test.name
+  table_name           = azurerm_storage_table.test.name
+
+  partition_key = "test_partition%d"
+  row_key       = "test_row%d"
+  entity = {
+    Simple   = 123.45
+    Negative = -3.14159
+    Large    = 1234567.5
+    Small    = 0.00001
+  }
+  entity_types = {
+    Simple   = "Edm.Double"
+    Negative = "Edm.Double"
+    Large    = "Edm.Double"
+    Small    = "Edm.Double"
+  }
+}
+`, template, data.RandomInteger, data.RandomInteger)
+}
+
+func (r StorageTableEntityResource) entityTypes(data acceptance.TestData, fooType string) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_table_entity" "test" {
+  storage_account_name = azurerm_storage_account.test.name
+  table_name           = azurerm_storage_table.test.name
+
+  partition_key = "test_partition%d"
+  row_key       = "test_row%d"
+  entity = {
+    Foo  = 123
+    Test = "Updated"
+  }
+  entity_types = {
+    Foo  = "%s"
+    Test = "Edm.String"
+  }
+}
+`, template, data.RandomInteger, data.RandomInteger, fooType)
+}
+
+func (r StorageTableEntityResource) entityTypesUnknownEntity(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_table_entity" "test" {
+  storage_account_name = azurerm_storage_account.test.name
+  table_name           = azurerm_storage_table.test.name
+
+  partition_key = "test_partition%d"
+  row_key       = "test_row%d"
+
+  # the ID of the Table isn't known until it's been created, so the entity is unknown during the plan
+  entity = {
+    Foo  = 123
+    Test = azurerm_storage_table.test.id
+  }
+  entity_types = {
+    Foo  = "Edm.Int32"
+    Test = "Edm.String"
+  }
+}
+`, template, data.RandomInteger, data.RandomInteger)
+}
+
+func (r StorageTableEntityResource) ignoreProperties(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_table_entity" "test" {
+  storage_account_name = azurerm_storage_account.test.name
+  table_name           = azurerm_storage_table.test.name
+
+  partition_key = "test_partition%d"
+  row_key       = "test_row%d"
+  entity = {
+    Foo = "Bar"
+  }
+  ignore_properties = ["Test"]
+}
+`, template, data.RandomInteger, data.RandomInteger)
+}
+
+func (r StorageTableEntityResource) emptyEntity(data acceptance.TestData, allowEmptyEntity bool) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_table_entity" "test" {
+  storage_account_name = azurerm_storage_account.test.name
+  table_name           = azurerm_storage_table.test.name
+
+  partition_key      = "test_partition%d"
+  row_key            = "test_row%d"
+  entity             = {}
+  allow_empty_entity = %t
+}
+`, template, data.RandomInteger, data.RandomInteger, allowEmptyEntity)
+}
+
+func (r StorageTableEntityResource) ifMatchETag(data acceptance.TestData, template string) string {
+	if template == "" {
+		template = r.template(data)
+	}
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_table_entity" "conditional" {
+  storage_account_name = azurerm_storage_account.test.name
+  table_name           = azurerm_storage_table.test.name
+
+  partition_key = "test_partition%d"
+  row_key       = "test_row%d"
+  entity = {
+    Foo = "Baz"
+  }
+  if_match_etag = "W/\"datetime'2000-01-01T00%%3A00%%3A00.0000000Z'\""
+}
+`, template, data.RandomInteger, data.RandomInteger)
+}
+
+func (r StorageTableEntityResource) missingTable(data acceptance.TestData, createTableIfMissing bool) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_table_entity" "test" {
+  storage_account_name = azurerm_storage_account.test.name
+  table_name           = "acctestmissing%d"
+
+  partition_key           = "test_partition%d"
+  row_key                 = "test_row%d"
+  create_table_if_missing = %t
+
+  entity = {
+    Foo = "Bar"
+  }
+}
+`, template, data.RandomInteger, data.RandomInteger, data.RandomInteger, createTableIfMissing)
+}
+
+func (r StorageTableEntityResource) errorOnUnsupportedPropertyTypes(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_table_entity" "test" {
+  storage_account_name = azurerm_storage_account.test.name
+  table_name           = azurerm_storage_table.test.name
+
+  partition_key                       = "test_partition%d"
+  row_key                             = "test_row%d"
+  error_on_unsupported_property_types = true
+
+  entity = {
+    Foo = "Bar"
+    Baz = 123
+  }
+}
+`, template, data.RandomInteger, data.RandomInteger)
+}
+
 func (r StorageTableEntityResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
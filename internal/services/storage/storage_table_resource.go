@@ -4,14 +4,22 @@
 package storage
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-09-01/storage" // nolint: staticcheck
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	intStor "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/client"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/shim"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -44,6 +52,8 @@ func resourceStorageTable() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceStorageTableCustomizeDiff),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -62,6 +72,7 @@ func resourceStorageTable() *pluginsdk.Resource {
 			"acl": {
 				Type:     pluginsdk.TypeSet,
 				Optional: true,
+				Set:      resourceStorageTableACLHash,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"id": {
@@ -76,18 +87,62 @@ func resourceStorageTable() *pluginsdk.Resource {
 								Schema: map[string]*pluginsdk.Schema{
 									"start": {
 										Type:         pluginsdk.TypeString,
-										Required:     true,
+										Optional:     true,
+										Computed:     true,
 										ValidateFunc: validation.StringIsNotEmpty,
 									},
+									"start_in": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: validate.StorageTableACLRelativeTime,
+									},
 									"expiry": {
 										Type:         pluginsdk.TypeString,
-										Required:     true,
+										Optional:     true,
+										Computed:     true,
 										ValidateFunc: validation.StringIsNotEmpty,
 									},
+									"expiry_in": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: validate.StorageTableACLRelativeTime,
+									},
 									"permissions": {
 										Type:         pluginsdk.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
+										Optional:     true,
+										ValidateFunc: validate.StorageTableACLPermissions,
+										DiffSuppressFunc: func(_, old, new string, _ *pluginsdk.ResourceData) bool {
+											return normalizeStorageTableACLPermissions(old) == normalizeStorageTableACLPermissions(new)
+										},
+									},
+									"permission": {
+										Type:     pluginsdk.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &pluginsdk.Resource{
+											Schema: map[string]*pluginsdk.Schema{
+												"read": {
+													Type:     pluginsdk.TypeBool,
+													Optional: true,
+													Default:  false,
+												},
+												"add": {
+													Type:     pluginsdk.TypeBool,
+													Optional: true,
+													Default:  false,
+												},
+												"update": {
+													Type:     pluginsdk.TypeBool,
+													Optional: true,
+													Default:  false,
+												},
+												"delete": {
+													Type:     pluginsdk.TypeBool,
+													Optional: true,
+													Default:  false,
+												},
+											},
+										},
 									},
 								},
 							},
@@ -95,10 +150,145 @@ func resourceStorageTable() *pluginsdk.Resource {
 					},
 				},
 			},
+
+			"error_on_expired_acl": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"acl_count": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
 		},
 	}
 }
 
+func resourceStorageTableCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+	// since `start`/`expiry` are Computed when `start_in`/`expiry_in` are specified, we need to check the raw config
+	acls := diff.GetRawConfig().GetAttr("acl")
+	if acls.IsNull() || !acls.IsKnown() {
+		return nil
+	}
+
+	for _, acl := range acls.AsValueSlice() {
+		policies := acl.GetAttr("access_policy")
+		if policies.IsNull() || !policies.IsKnown() {
+			continue
+		}
+
+		for _, policy := range policies.AsValueSlice() {
+			for _, key := range []string{"start", "expiry"} {
+				relativeKey := fmt.Sprintf("%s_in", key)
+				absolute := policy.GetAttr(key)
+				relative := policy.GetAttr(relativeKey)
+
+				if !absolute.IsNull() && !relative.IsNull() {
+					return fmt.Errorf("only one of `%s` or `%s` can be specified within an `access_policy` block", key, relativeKey)
+				}
+				// the `start` can be omitted, in which case the Access Policy is valid immediately
+				if key == "expiry" && absolute.IsNull() && relative.IsNull() {
+					return fmt.Errorf("one of `%s` or `%s` must be specified within an `access_policy` block", key, relativeKey)
+				}
+			}
+
+			if err := validateStorageTableACLPermissionsConfig(policy); err != nil {
+				return err
+			}
+		}
+	}
+
+	// an ACL whose `expiry` has already passed can never be used, which is almost always a mistake - this is only
+	// checked for the ACL's being added or changed, since the existing ACL's are expected to expire over time. Since
+	// existing configurations may (re)create such an ACL this is only an error when `error_on_expired_acl` is set.
+	if diff.HasChange("acl") {
+		oldAcls, newAcls := diff.GetChange("acl")
+		if err := validateStorageTableACLsNotExpired(oldAcls.(*pluginsdk.Set), newAcls.(*pluginsdk.Set), time.Now()); err != nil {
+			if diff.Get("error_on_expired_acl").(bool) {
+				return err
+			}
+			log.Printf("[WARN] %+v", err)
+		}
+	}
+
+	if diff.Id() != "" && diff.HasChange("acl") {
+		if err := diff.SetNewComputed("acl_count"); err != nil {
+			return fmt.Errorf("setting `acl_count` as computed: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+// validateStorageTableACLPermissionsConfig ensures that the permissions within an `access_policy` block are specified
+// using exactly one of the `permissions` string or the `permission` block, the latter granting at least one permission
+func validateStorageTableACLPermissionsConfig(policy cty.Value) error {
+	permissions := policy.GetAttr("permissions")
+	permission := policy.GetAttr("permission")
+	if !permissions.IsKnown() || !permission.IsKnown() {
+		return nil
+	}
+
+	hasBlock := !permission.IsNull() && permission.LengthInt() > 0
+	if !permissions.IsNull() && hasBlock {
+		return fmt.Errorf("only one of `permissions` or `permission` can be specified within an `access_policy` block")
+	}
+	if permissions.IsNull() && !hasBlock {
+		return fmt.Errorf("one of `permissions` or `permission` must be specified within an `access_policy` block")
+	}
+	if !hasBlock {
+		return nil
+	}
+
+	for _, block := range permission.AsValueSlice() {
+		for _, key := range []string{"read", "add", "update", "delete"} {
+			value := block.GetAttr(key)
+			if !value.IsKnown() || (!value.IsNull() && value.True()) {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("at least one of `read`, `add`, `update` or `delete` must be enabled within a `permission` block")
+}
+
+// validateStorageTableACLsNotExpired returns an error when an ACL within `new` (which isn't within `old`) has an
+// absolute `expiry` earlier than `now`. ACL's using `expiry_in` are resolved relative to the current time, so
+// can't have expired - as can't an `expiry` which isn't known until apply.
+func validateStorageTableACLsNotExpired(old, new *pluginsdk.Set, now time.Time) error {
+	for _, raw := range new.List() {
+		if old != nil && old.Contains(raw) {
+			continue
+		}
+
+		acl, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		policies, _ := acl["access_policy"].([]interface{})
+		for _, v := range policies {
+			policy, ok := v.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if relative, _ := policy["expiry_in"].(string); relative != "" {
+				continue
+			}
+
+			expiryRaw, _ := policy["expiry"].(string)
+			expiry, err := time.Parse(time.RFC3339, expiryRaw)
+			if err != nil {
+				continue
+			}
+			if expiry.Before(now) {
+				return fmt.Errorf("the `expiry` (%q) of the `access_policy` for the ACL %q is in the past, meaning this ACL can never be used", expiryRaw, acl["id"])
+			}
+		}
+	}
+
+	return nil
+}
+
 func resourceStorageTableCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -107,7 +297,10 @@ func resourceStorageTableCreate(d *pluginsdk.ResourceData, meta interface{}) err
 	tableName := d.Get("name").(string)
 	accountName := d.Get("storage_account_name").(string)
 	aclsRaw := d.Get("acl").(*pluginsdk.Set).List()
-	acls := expandStorageTableACLs(aclsRaw)
+	acls, err := expandStorageTableACLs(aclsRaw, nil, time.Now())
+	if err != nil {
+		return fmt.Errorf("expanding `acl`: %+v", err)
+	}
 
 	account, err := storageClient.FindAccount(ctx, accountName)
 	if err != nil {
@@ -122,9 +315,17 @@ func resourceStorageTableCreate(d *pluginsdk.ResourceData, meta interface{}) err
 		return fmt.Errorf("building Table Client: %s", err)
 	}
 
-	id := parse.NewStorageTableDataPlaneId(accountName, storageClient.Environment.StorageEndpointSuffix, tableName).ID()
+	host, err := account.DataPlaneHost(intStor.EndpointTypeTable)
+	if err != nil {
+		return err
+	}
+	dataPlaneId := parse.NewStorageTableDataPlaneId(accountName, host.DomainSuffix, tableName)
+	dataPlaneId.DnsZone = host.DnsZone
+	id := dataPlaneId.ID()
 
-	exists, err := client.Exists(ctx, account.ResourceGroup, accountName, tableName)
+	exists, err := checkExistsWithTimeout(ctx, func(ctx context.Context) (*bool, error) {
+		return client.Exists(ctx, account.ResourceGroup, accountName, tableName)
+	})
 	if err != nil {
 		return fmt.Errorf("checking for existence of existing Storage Table %q (Account %q / Resource Group %q): %+v", tableName, accountName, account.ResourceGroup, err)
 	}
@@ -139,7 +340,13 @@ func resourceStorageTableCreate(d *pluginsdk.ResourceData, meta interface{}) err
 
 	d.SetId(id)
 	if err := client.UpdateACLs(ctx, account.ResourceGroup, accountName, tableName, acls); err != nil {
-		return fmt.Errorf("setting ACL's for Storage Table %q (Account %q / Resource Group %q): %+v", tableName, accountName, account.ResourceGroup, err)
+		if !intStor.DataPlaneOperationNotSupported(err) {
+			return fmt.Errorf("setting ACL's for Storage Table %q (Account %q / Resource Group %q): %+v", tableName, accountName, account.ResourceGroup, intStor.RejectedSignedIdentifierError(err, storageTableSignedIdentifiers(acls)))
+		}
+		if len(acls) > 0 {
+			return storageTableACLsNotSupportedError(accountName, account.Kind, err)
+		}
+		log.Printf("[DEBUG] ACL's aren't supported for Storage Tables within Storage Account %q (Kind %q) - skipping since no ACL's are configured", accountName, account.Kind)
 	}
 
 	return resourceStorageTableRead(d, meta)
@@ -170,7 +377,9 @@ func resourceStorageTableRead(d *pluginsdk.ResourceData, meta interface{}) error
 		return fmt.Errorf("building Table Client: %s", err)
 	}
 
-	exists, err := client.Exists(ctx, account.ResourceGroup, id.AccountName, id.Name)
+	exists, err := checkExistsWithTimeout(ctx, func(ctx context.Context) (*bool, error) {
+		return client.Exists(ctx, account.ResourceGroup, id.AccountName, id.Name)
+	})
 	if err != nil {
 		return fmt.Errorf("retrieving Table %q (Storage Account %q / Resource Group %q): %s", id.Name, id.AccountName, account.ResourceGroup, err)
 	}
@@ -180,18 +389,46 @@ func resourceStorageTableRead(d *pluginsdk.ResourceData, meta interface{}) error
 		return nil
 	}
 
-	acls, err := client.GetACLs(ctx, account.ResourceGroup, id.AccountName, id.Name)
+	// the host within the ID differs from that of the Storage Account's endpoint when the state has been moved between
+	// clouds, or when the ID was built without the DNS Zone used by the Storage Account - since the Table exists the ID
+	// is updated, rather than the Table needing to be recreated
+	host, err := account.DataPlaneHost(intStor.EndpointTypeTable)
 	if err != nil {
-		return fmt.Errorf("retrieving ACL's %q in Storage Account %q: %s", id.Name, id.AccountName, err)
+		return err
+	}
+	if id.DomainSuffix != host.DomainSuffix || id.DnsZone != host.DnsZone {
+		newId := *id
+		newId.DomainSuffix = host.DomainSuffix
+		newId.DnsZone = host.DnsZone
+		log.Printf("[DEBUG] Updating the ID for %s to use the Domain Suffix %q and DNS Zone %q", id, host.DomainSuffix, host.DnsZone)
+		d.SetId(newId.ID())
+	}
+
+	acls, err := getStorageTableACLsWithTimeout(ctx, client, account.ResourceGroup, id.AccountName, id.Name)
+	if err != nil {
+		// ACL's aren't supported for Tables within some kinds of Storage Account (e.g. Premium), in which case
+		// there are no ACL's - so any ACL's which are configured show as a diff, which errors when applied
+		if !intStor.DataPlaneOperationNotSupported(err) {
+			return fmt.Errorf("retrieving ACL's %q in Storage Account %q: %s", id.Name, id.AccountName, err)
+		}
+		log.Printf("[DEBUG] ACL's aren't supported for Storage Tables within Storage Account %q (Kind %q) - assuming there are none", id.AccountName, account.Kind)
+		acls = &[]tables.SignedIdentifier{}
 	}
 
 	d.Set("name", id.Name)
 	d.Set("storage_account_name", id.AccountName)
 
-	if err := d.Set("acl", flattenStorageTableACLs(acls)); err != nil {
+	if err := d.Set("acl", flattenStorageTableACLs(acls, d.Get("acl").(*pluginsdk.Set).List())); err != nil {
 		return fmt.Errorf("flattening `acl`: %+v", err)
 	}
 
+	// this includes any Stored Access Policies added outside of Terraform
+	aclCount := 0
+	if acls != nil {
+		aclCount = len(*acls)
+	}
+	d.Set("acl_count", aclCount)
+
 	return nil
 }
 
@@ -252,11 +489,21 @@ func resourceStorageTableUpdate(d *pluginsdk.ResourceData, meta interface{}) err
 	if d.HasChange("acl") {
 		log.Printf("[DEBUG] Updating the ACL's for Storage Table %q (Storage Account %q)", id.Name, id.AccountName)
 
-		aclsRaw := d.Get("acl").(*pluginsdk.Set).List()
-		acls := expandStorageTableACLs(aclsRaw)
+		// the ACL's which haven't changed should retain the start/expiry times resolved from `start_in`/`expiry_in`
+		oldAclsRaw, newAclsRaw := d.GetChange("acl")
+		acls, err := expandStorageTableACLs(newAclsRaw.(*pluginsdk.Set).List(), oldAclsRaw.(*pluginsdk.Set), time.Now())
+		if err != nil {
+			return fmt.Errorf("expanding `acl`: %+v", err)
+		}
 
 		if err := client.UpdateACLs(ctx, account.ResourceGroup, id.AccountName, id.Name, acls); err != nil {
-			return fmt.Errorf("updating ACL's for Table %q (Storage Account %q): %s", id.Name, id.AccountName, err)
+			if !intStor.DataPlaneOperationNotSupported(err) {
+				return fmt.Errorf("updating ACL's for Table %q (Storage Account %q): %s", id.Name, id.AccountName, intStor.RejectedSignedIdentifierError(err, storageTableSignedIdentifiers(acls)))
+			}
+			if len(acls) > 0 {
+				return storageTableACLsNotSupportedError(id.AccountName, account.Kind, err)
+			}
+			log.Printf("[DEBUG] ACL's aren't supported for Storage Tables within Storage Account %q (Kind %q) - skipping since no ACL's are configured", id.AccountName, account.Kind)
 		}
 
 		log.Printf("[DEBUG] Updated the ACL's for Storage Table %q (Storage Account %q)", id.Name, id.AccountName)
@@ -265,43 +512,176 @@ func resourceStorageTableUpdate(d *pluginsdk.ResourceData, meta interface{}) err
 	return resourceStorageTableRead(d, meta)
 }
 
-func expandStorageTableACLs(input []interface{}) []tables.SignedIdentifier {
+// getStorageTableACLsWithTimeout retrieves the ACL's for the Table using a Context limited to a fraction of the time
+// remaining for the operation (as for the existence check), so that a misbehaving endpoint fails fast with a clear
+// error rather than consuming the entire timeout for the read
+func getStorageTableACLsWithTimeout(ctx context.Context, client shim.StorageTableWrapper, resourceGroup, accountName, tableName string) (*[]tables.SignedIdentifier, error) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return client.GetACLs(ctx, resourceGroup, accountName, tableName)
+	}
+
+	timeout := existenceCheckTimeout(time.Until(deadline))
+	aclCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	acls, err := client.GetACLs(aclCtx, resourceGroup, accountName, tableName)
+	if err != nil && ctx.Err() == nil && errors.Is(aclCtx.Err(), context.DeadlineExceeded) {
+		return nil, fmt.Errorf("timed out reading ACL's for Table %q (Storage Account %q) after %s, this can happen when the Storage Account isn't reachable from where Terraform is running (for example due to Network Rules or a Private Endpoint): %+v", tableName, accountName, timeout, err)
+	}
+
+	return acls, err
+}
+
+// storageTableACLsNotSupportedError returns a clearer error when ACL's are configured for a Table within a kind
+// of Storage Account which doesn't support these, since the Table Service otherwise returns a 501 NotImplemented
+func storageTableACLsNotSupportedError(accountName string, kind storage.Kind, err error) error {
+	return fmt.Errorf("table ACL's aren't supported on this account kind (Storage Account %q is of Kind %q) - remove the `acl` blocks to manage this Table: %+v", accountName, kind, err)
+}
+
+// expandStorageTableACLs expands the ACL's, resolving any relative `start_in`/`expiry_in` times into absolute
+// times relative to `now` - unless the ACL is unchanged from `existing`, in which case the times previously
+// resolved are used, so that these are only recomputed when the ACL changes.
+func expandStorageTableACLs(input []interface{}, existing *pluginsdk.Set, now time.Time) ([]tables.SignedIdentifier, error) {
 	results := make([]tables.SignedIdentifier, 0)
 
 	for _, v := range input {
 		vals := v.(map[string]interface{})
+		id := vals["id"].(string)
 
 		policies := vals["access_policy"].([]interface{})
 		policy := policies[0].(map[string]interface{})
 
+		var existingPolicy map[string]interface{}
+		if existing != nil {
+			for _, e := range existing.List() {
+				if resourceStorageTableACLHash(e) != resourceStorageTableACLHash(v) {
+					continue
+				}
+				if existingPolicies := e.(map[string]interface{})["access_policy"].([]interface{}); len(existingPolicies) > 0 {
+					existingPolicy = existingPolicies[0].(map[string]interface{})
+				}
+			}
+		}
+
+		// the `start` is optional - when omitted this is left empty, meaning the Access Policy is valid immediately
+		start, err := expandStorageTableACLTime(policy, existingPolicy, "start", false, now)
+		if err != nil {
+			return nil, fmt.Errorf("ACL %q: %+v", id, err)
+		}
+		expiry, err := expandStorageTableACLTime(policy, existingPolicy, "expiry", true, now)
+		if err != nil {
+			return nil, fmt.Errorf("ACL %q: %+v", id, err)
+		}
+
 		identifier := tables.SignedIdentifier{
-			Id: vals["id"].(string),
+			Id: id,
 			AccessPolicy: tables.AccessPolicy{
-				Start:      policy["start"].(string),
-				Expiry:     policy["expiry"].(string),
-				Permission: policy["permissions"].(string),
+				Start:      start,
+				Expiry:     expiry,
+				Permission: expandStorageTableACLPermissions(policy),
 			},
 		}
 		results = append(results, identifier)
 	}
 
+	return results, nil
+}
+
+// storageTableSignedIdentifiers returns the ACL's in the form used to identify which of these was rejected
+func storageTableSignedIdentifiers(input []tables.SignedIdentifier) []intStor.SignedIdentifier {
+	results := make([]intStor.SignedIdentifier, 0)
+	for _, v := range input {
+		results = append(results, intStor.SignedIdentifier{
+			Id:         v.Id,
+			Start:      v.AccessPolicy.Start,
+			Expiry:     v.AccessPolicy.Expiry,
+			Permission: v.AccessPolicy.Permission,
+		})
+	}
 	return results
 }
 
-func flattenStorageTableACLs(input *[]tables.SignedIdentifier) []interface{} {
+func expandStorageTableACLTime(policy, existingPolicy map[string]interface{}, key string, required bool, now time.Time) (string, error) {
+	relativeKey := fmt.Sprintf("%s_in", key)
+	absolute, _ := policy[key].(string)
+	relative, _ := policy[relativeKey].(string)
+
+	if relative == "" {
+		if absolute == "" && required {
+			return "", fmt.Errorf("one of `%s` or `%s` must be specified", key, relativeKey)
+		}
+		return absolute, nil
+	}
+
+	if existingPolicy != nil {
+		if resolved, _ := existingPolicy[key].(string); resolved != "" {
+			return resolved, nil
+		}
+	}
+
+	duration, err := time.ParseDuration(relative)
+	if err != nil {
+		return "", fmt.Errorf("parsing `%s`: %+v", relativeKey, err)
+	}
+
+	return now.Add(duration).UTC().Format(time.RFC3339), nil
+}
+
+func flattenStorageTableACLs(input *[]tables.SignedIdentifier, existing []interface{}) []interface{} {
 	result := make([]interface{}, 0)
 	if input == nil {
 		return result
 	}
 
+	// the relative times (and which form the permissions were specified in) aren't returned from the API, so we
+	// pull these from the existing ACL's
+	existingPolicies := make(map[string]map[string]interface{})
+	for _, v := range existing {
+		vals, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if policies, ok := vals["access_policy"].([]interface{}); ok && len(policies) > 0 {
+			if policy, ok := policies[0].(map[string]interface{}); ok {
+				existingPolicies[vals["id"].(string)] = policy
+			}
+		}
+	}
+
 	for _, v := range *input {
+		startIn := ""
+		expiryIn := ""
+		usePermissionBlock := false
+		if policy, ok := existingPolicies[v.Id]; ok {
+			startIn, _ = policy["start_in"].(string)
+			expiryIn, _ = policy["expiry_in"].(string)
+			if blocks, _ := policy["permission"].([]interface{}); len(blocks) > 0 {
+				usePermissionBlock = true
+			}
+		}
+
+		permissions := normalizeStorageTableACLPermissions(v.AccessPolicy.Permission)
+		permission := make([]interface{}, 0)
+		if usePermissionBlock {
+			permission = flattenStorageTableACLPermission(permissions)
+			permissions = ""
+		}
+
+		// when no `start` was specified the Table Service omits this, which is flattened as an empty string - since
+		// `start` is Computed (and empty values are hashed identically) this doesn't show a diff
+		start := v.AccessPolicy.Start
+
 		output := map[string]interface{}{
 			"id": v.Id,
 			"access_policy": []interface{}{
 				map[string]interface{}{
-					"start":       v.AccessPolicy.Start,
+					"start":       start,
+					"start_in":    startIn,
 					"expiry":      v.AccessPolicy.Expiry,
-					"permissions": v.AccessPolicy.Permission,
+					"expiry_in":   expiryIn,
+					"permissions": permissions,
+					"permission":  permission,
 				},
 			},
 		}
@@ -311,3 +691,86 @@ func flattenStorageTableACLs(input *[]tables.SignedIdentifier) []interface{} {
 
 	return result
 }
+
+// expandStorageTableACLPermissions returns the canonical permissions for an `access_policy` block, which are either
+// specified as the `permissions` string or combined from the booleans within the `permission` block
+func expandStorageTableACLPermissions(policy map[string]interface{}) string {
+	if permissions, _ := policy["permissions"].(string); permissions != "" {
+		return normalizeStorageTableACLPermissions(permissions)
+	}
+
+	output := ""
+	blocks, _ := policy["permission"].([]interface{})
+	for _, raw := range blocks {
+		block, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, v := range []struct {
+			key        string
+			permission string
+		}{
+			{"read", "r"},
+			{"add", "a"},
+			{"update", "u"},
+			{"delete", "d"},
+		} {
+			if enabled, _ := block[v.key].(bool); enabled {
+				output += v.permission
+			}
+		}
+	}
+	return output
+}
+
+// flattenStorageTableACLPermission returns the `permission` block for the specified (canonical) permissions
+func flattenStorageTableACLPermission(input string) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"read":   strings.ContainsRune(input, 'r'),
+			"add":    strings.ContainsRune(input, 'a'),
+			"update": strings.ContainsRune(input, 'u'),
+			"delete": strings.ContainsRune(input, 'd'),
+		},
+	}
+}
+
+// normalizeStorageTableACLPermissions returns the permissions in the order used by the Table Service (`raud`),
+// since the API canonicalizes this value - meaning `dar` would otherwise be returned as `rad`.
+func normalizeStorageTableACLPermissions(input string) string {
+	output := ""
+	for _, c := range "raud" {
+		if strings.ContainsRune(input, c) {
+			output += string(c)
+		}
+	}
+	return output
+}
+
+func resourceStorageTableACLHash(v interface{}) int {
+	var buf bytes.Buffer
+
+	if m, ok := v.(map[string]interface{}); ok {
+		buf.WriteString(fmt.Sprintf("%s-", m["id"].(string)))
+
+		if policies, ok := m["access_policy"].([]interface{}); ok {
+			for _, raw := range policies {
+				policy, ok := raw.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				// when a relative time is specified the resolved time is Computed, so this mustn't be part of the hash
+				for _, key := range []string{"start", "expiry"} {
+					if relative, _ := policy[fmt.Sprintf("%s_in", key)].(string); relative != "" {
+						buf.WriteString(fmt.Sprintf("%s-", relative))
+					} else {
+						buf.WriteString(fmt.Sprintf("%s-", policy[key].(string)))
+					}
+				}
+				buf.WriteString(fmt.Sprintf("%s-", expandStorageTableACLPermissions(policy)))
+			}
+		}
+	}
+
+	return pluginsdk.HashString(buf.String())
+}
@@ -6,6 +6,7 @@ package storage_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -48,6 +49,31 @@ func TestAccStorageTable_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccStorageTable_recreateAfterDelete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_table", "test")
+	r := StorageTableResource{}
+
+	// the Table is removed asynchronously, so recreating this with the same name waits for the delete to complete
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config: r.withoutTable(data),
+		},
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccStorageTable_disappears(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_storage_table", "test")
 	r := StorageTableResource{}
@@ -69,6 +95,7 @@ func TestAccStorageTable_acl(t *testing.T) {
 			Config: r.acl(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("acl_count").HasValue("1"),
 			),
 		},
 		data.ImportStep(),
@@ -76,12 +103,91 @@ func TestAccStorageTable_acl(t *testing.T) {
 			Config: r.aclUpdated(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("acl_count").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageTable_aclRelativeTimes(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_table", "test")
+	r := StorageTableResource{}
+
+	// the relative times aren't returned from the API, so this can't be imported
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.aclRelativeTimes(data, "raud"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("acl.#").HasValue("2"),
+			),
+		},
+		{
+			Config: r.aclRelativeTimes(data, "r"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("acl.#").HasValue("2"),
+			),
+		},
+	})
+}
+
+func TestAccStorageTable_aclWithoutStart(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_table", "test")
+	r := StorageTableResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.aclWithoutStart(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("acl.#").HasValue("1"),
 			),
 		},
 		data.ImportStep(),
 	})
 }
 
+func TestAccStorageTable_aclPermissionBlock(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_table", "test")
+	r := StorageTableResource{}
+
+	// the form the permissions were specified in isn't returned from the API, so this can't be imported
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.aclPermissionBlock(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("acl.#").HasValue("1"),
+			),
+		},
+		{
+			Config:      r.aclPermissionBlockConflict(data),
+			ExpectError: regexp.MustCompile("only one of `permissions` or `permission` can be specified"),
+		},
+	})
+}
+
+func TestAccStorageTable_aclExpired(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_table", "test")
+	r := StorageTableResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.aclExpired(data, true),
+			ExpectError: regexp.MustCompile("is in the past, meaning this ACL can never be used"),
+		},
+		{
+			Config: r.aclExpired(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("error_on_expired_acl"),
+	})
+}
+
 func (r StorageTableResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.StorageTableDataPlaneID(state.ID)
 	if err != nil {
@@ -162,6 +268,31 @@ resource "azurerm_storage_table" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomString, data.RandomInteger)
 }
 
+func (r StorageTableResource) withoutTable(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+
+  tags = {
+    environment = "staging"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
 func (r StorageTableResource) requiresImport(data acceptance.TestData) string {
 	template := r.basic(data)
 	return fmt.Sprintf(`
@@ -206,7 +337,7 @@ resource "azurerm_storage_table" "test" {
     access_policy {
       permissions = "raud"
       start       = "2020-11-26T08:49:37.0000000Z"
-      expiry      = "2020-11-27T08:49:37.0000000Z"
+      expiry      = "2099-11-27T08:49:37.0000000Z"
     }
   }
 }
@@ -246,7 +377,7 @@ resource "azurerm_storage_table" "test" {
     access_policy {
       permissions = "raud"
       start       = "2020-11-26T08:49:37.0000000Z"
-      expiry      = "2020-11-27T08:49:37.0000000Z"
+      expiry      = "2099-11-27T08:49:37.0000000Z"
     }
   }
   acl {
@@ -255,9 +386,196 @@ resource "azurerm_storage_table" "test" {
     access_policy {
       permissions = "raud"
       start       = "2019-07-02T09:38:21.0000000Z"
-      expiry      = "2019-07-02T10:38:21.0000000Z"
+      expiry      = "2099-07-02T10:38:21.0000000Z"
     }
   }
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomString, data.RandomInteger)
 }
+
+func (r StorageTableResource) aclRelativeTimes(data acceptance.TestData, permissions string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_table" "test" {
+  name                 = "acctestst%d"
+  storage_account_name = azurerm_storage_account.test.name
+
+  acl {
+    id = "MTIzNDU2Nzg5MDEyMzQ1Njc4OTAxMjM0NTY3ODkwMTI"
+
+    access_policy {
+      permissions = "%s"
+      start_in    = "0s"
+      expiry_in   = "24h"
+    }
+  }
+  acl {
+    id = "AAAANDU2Nzg5MDEyMzQ1Njc4OTAxMjM0NTY3ODkwMTI"
+
+    access_policy {
+      permissions = "r"
+      start       = "2020-11-26T08:49:37.0000000Z"
+      expiry_in   = "720h"
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, data.RandomInteger, permissions)
+}
+
+func (r StorageTableResource) aclWithoutStart(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_table" "test" {
+  name                 = "acctestst%d"
+  storage_account_name = azurerm_storage_account.test.name
+
+  acl {
+    id = "MTIzNDU2Nzg5MDEyMzQ1Njc4OTAxMjM0NTY3ODkwMTI"
+
+    access_policy {
+      permissions = "r"
+      expiry      = "2099-11-27T08:49:37.0000000Z"
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, data.RandomInteger)
+}
+
+func (r StorageTableResource) aclPermissionBlock(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_table" "test" {
+  name                 = "acctestst%d"
+  storage_account_name = azurerm_storage_account.test.name
+
+  acl {
+    id = "MTIzNDU2Nzg5MDEyMzQ1Njc4OTAxMjM0NTY3ODkwMTI"
+
+    access_policy {
+      expiry = "2099-11-27T08:49:37.0000000Z"
+
+      permission {
+        read   = true
+        update = true
+      }
+    }
+  }
+}
+`, r.aclTemplate(data), data.RandomInteger)
+}
+
+func (r StorageTableResource) aclPermissionBlockConflict(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_table" "test" {
+  name                 = "acctestst%d"
+  storage_account_name = azurerm_storage_account.test.name
+
+  acl {
+    id = "MTIzNDU2Nzg5MDEyMzQ1Njc4OTAxMjM0NTY3ODkwMTI"
+
+    access_policy {
+      expiry      = "2099-11-27T08:49:37.0000000Z"
+      permissions = "ru"
+
+      permission {
+        read   = true
+        update = true
+      }
+    }
+  }
+}
+`, r.aclTemplate(data), data.RandomInteger)
+}
+
+func (r StorageTableResource) aclTemplate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
+func (r StorageTableResource) aclExpired(data acceptance.TestData, errorOnExpiredAcl bool) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_table" "test" {
+  name                 = "acctestst%d"
+  storage_account_name = azurerm_storage_account.test.name
+  error_on_expired_acl = %t
+
+  acl {
+    id = "MTIzNDU2Nzg5MDEyMzQ1Njc4OTAxMjM0NTY3ODkwMTI"
+
+    access_policy {
+      permissions = "r"
+      start       = "2020-11-26T08:49:37.0000000Z"
+      expiry      = "2020-11-27T08:49:37.0000000Z"
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, data.RandomInteger, errorOnExpiredAcl)
+}
@@ -0,0 +1,265 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/helpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/queue/queues"
+)
+
+func resourceStorageTableServiceProperties() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceStorageTableServicePropertiesCreateUpdate,
+		Read:   resourceStorageTableServicePropertiesRead,
+		Update: resourceStorageTableServicePropertiesCreateUpdate,
+		Delete: resourceStorageTableServicePropertiesDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := commonids.ParseStorageAccountID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"storage_account_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: commonids.ValidateStorageAccountID,
+			},
+
+			// the PATCH method is only supported by the Blob Service
+			"cors_rule": helpers.SchemaStorageAccountCorsRule(false),
+
+			"logging": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"version": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"delete": {
+							Type:     pluginsdk.TypeBool,
+							Required: true,
+						},
+						"read": {
+							Type:     pluginsdk.TypeBool,
+							Required: true,
+						},
+						"write": {
+							Type:     pluginsdk.TypeBool,
+							Required: true,
+						},
+						"retention_policy_days": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 365),
+						},
+					},
+				},
+			},
+
+			"hour_metrics": storageTableServicePropertiesMetricsSchema(),
+
+			"minute_metrics": storageTableServicePropertiesMetricsSchema(),
+		},
+	}
+}
+
+func storageTableServicePropertiesMetricsSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"version": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				"enabled": {
+					Type:     pluginsdk.TypeBool,
+					Required: true,
+				},
+				"include_apis": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+				},
+				"retention_policy_days": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(1, 365),
+				},
+			},
+		},
+	}
+}
+
+func resourceStorageTableServicePropertiesCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := commonids.ParseStorageAccountID(d.Get("storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	account, err := storageClient.FindAccount(ctx, id.StorageAccountName)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if account == nil {
+		return fmt.Errorf("unable to locate %s", id)
+	}
+
+	client, err := storageClient.TableServicePropertiesClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building Table Service Properties Client: %+v", err)
+	}
+
+	// the Table Service always has properties (e.g. Hour Metrics are enabled by default), so these are managed as a
+	// whole - where a block which isn't specified is disabled, rather than being left as-is
+	props, err := expandQueueProperties([]interface{}{
+		map[string]interface{}{
+			"cors_rule":      d.Get("cors_rule").([]interface{}),
+			"logging":        d.Get("logging").([]interface{}),
+			"hour_metrics":   d.Get("hour_metrics").([]interface{}),
+			"minute_metrics": d.Get("minute_metrics").([]interface{}),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("expanding the Table Service Properties: %+v", err)
+	}
+
+	if _, err := client.SetServiceProperties(ctx, id.StorageAccountName, props); err != nil {
+		return fmt.Errorf("updating the Table Service Properties for %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceStorageTableServicePropertiesRead(d, meta)
+}
+
+func resourceStorageTableServicePropertiesRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := commonids.ParseStorageAccountID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	account, err := storageClient.FindAccount(ctx, id.StorageAccountName)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if account == nil {
+		log.Printf("[DEBUG] Unable to locate %s - assuming removed & removing from state", id)
+		d.SetId("")
+		return nil
+	}
+
+	client, err := storageClient.TableServicePropertiesClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building Table Service Properties Client: %+v", err)
+	}
+
+	resp, err := client.GetServiceProperties(ctx, id.StorageAccountName)
+	if err != nil {
+		return fmt.Errorf("retrieving the Table Service Properties for %s: %+v", id, err)
+	}
+
+	d.Set("storage_account_id", id.ID())
+
+	corsRules := make([]interface{}, 0)
+	if cors := resp.Cors; cors != nil {
+		corsRules = flattenQueuePropertiesCorsRule(cors.CorsRule)
+	}
+	if err := d.Set("cors_rule", corsRules); err != nil {
+		return fmt.Errorf("setting `cors_rule`: %+v", err)
+	}
+
+	// Logging and Metrics which are disabled are equivalent to the block being omitted, so are omitted here
+	logging := make([]interface{}, 0)
+	if v := resp.Logging; v != nil && (v.Delete || v.Read || v.Write || v.RetentionPolicy.Enabled) {
+		logging = flattenQueuePropertiesLogging(*v)
+	}
+	if err := d.Set("logging", logging); err != nil {
+		return fmt.Errorf("setting `logging`: %+v", err)
+	}
+
+	if err := d.Set("hour_metrics", flattenStorageTableServicePropertiesMetrics(resp.HourMetrics)); err != nil {
+		return fmt.Errorf("setting `hour_metrics`: %+v", err)
+	}
+	if err := d.Set("minute_metrics", flattenStorageTableServicePropertiesMetrics(resp.MinuteMetrics)); err != nil {
+		return fmt.Errorf("setting `minute_metrics`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceStorageTableServicePropertiesDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := commonids.ParseStorageAccountID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	account, err := storageClient.FindAccount(ctx, id.StorageAccountName)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if account == nil {
+		return fmt.Errorf("unable to locate %s", id)
+	}
+
+	client, err := storageClient.TableServicePropertiesClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building Table Service Properties Client: %+v", err)
+	}
+
+	// the Table Service Properties can't be removed, so the CORS Rules, Logging and Metrics are disabled instead
+	props, err := expandQueueProperties([]interface{}{})
+	if err != nil {
+		return fmt.Errorf("expanding the Table Service Properties: %+v", err)
+	}
+	if _, err := client.SetServiceProperties(ctx, id.StorageAccountName, props); err != nil {
+		return fmt.Errorf("resetting the Table Service Properties for %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func flattenStorageTableServicePropertiesMetrics(input *queues.MetricsConfig) []interface{} {
+	if input == nil || (!input.Enabled && !input.RetentionPolicy.Enabled) {
+		return []interface{}{}
+	}
+
+	return flattenQueuePropertiesMetrics(*input)
+}
@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StorageTableServicePropertiesResource struct{}
+
+func TestAccStorageTableServiceProperties_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_table_service_properties", "test")
+	r := StorageTableServicePropertiesResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("hour_metrics.#").HasValue("1"),
+				check.That(data.ResourceName).Key("logging.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageTableServiceProperties_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_table_service_properties", "test")
+	r := StorageTableServicePropertiesResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageTableServiceProperties_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_table_service_properties", "test")
+	r := StorageTableServicePropertiesResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.empty(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("cors_rule.#").HasValue("0"),
+				check.That(data.ResourceName).Key("logging.#").HasValue("0"),
+				check.That(data.ResourceName).Key("hour_metrics.#").HasValue("0"),
+				check.That(data.ResourceName).Key("minute_metrics.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r StorageTableServicePropertiesResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := commonids.ParseStorageAccountID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := client.Storage.FindAccount(ctx, id.StorageAccountName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if account == nil {
+		return utils.Bool(false), nil
+	}
+
+	tableServiceClient, err := client.Storage.TableServicePropertiesClient(ctx, *account)
+	if err != nil {
+		return nil, fmt.Errorf("building Table Service Properties Client: %+v", err)
+	}
+
+	if _, err := tableServiceClient.GetServiceProperties(ctx, id.StorageAccountName); err != nil {
+		return nil, fmt.Errorf("retrieving the Table Service Properties for %s: %+v", id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r StorageTableServicePropertiesResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_table_service_properties" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+
+  hour_metrics {
+    version               = "1.0"
+    enabled               = true
+    include_apis          = true
+    retention_policy_days = 7
+  }
+}
+`, r.template(data))
+}
+
+func (r StorageTableServicePropertiesResource) empty(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_table_service_properties" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+}
+`, r.template(data))
+}
+
+func (r StorageTableServicePropertiesResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_table_service_properties" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+
+  cors_rule {
+    allowed_origins    = ["http://www.example.com"]
+    exposed_headers    = ["x-tempo-*"]
+    allowed_headers    = ["x-tempo-*"]
+    allowed_methods    = ["GET", "PUT"]
+    max_age_in_seconds = 500
+  }
+
+  logging {
+    version               = "1.0"
+    delete                = true
+    read                  = true
+    write                 = true
+    retention_policy_days = 7
+  }
+
+  hour_metrics {
+    version               = "1.0"
+    enabled               = true
+    include_apis          = true
+    retention_policy_days = 7
+  }
+
+  minute_metrics {
+    version               = "1.0"
+    enabled               = true
+    include_apis          = false
+    retention_policy_days = 7
+  }
+}
+`, r.template(data))
+}
+
+func (r StorageTableServicePropertiesResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "unlikely23exst2acct%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
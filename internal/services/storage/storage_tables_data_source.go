@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type storageTablesDataSource struct{}
+
+var _ sdk.DataSource = storageTablesDataSource{}
+
+type storageTablesDataSourceModel struct {
+	StorageAccountId string   `tfschema:"storage_account_id"`
+	Names            []string `tfschema:"names"`
+}
+
+func (r storageTablesDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: commonids.ValidateStorageAccountID,
+		},
+	}
+}
+
+func (r storageTablesDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"names": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r storageTablesDataSource) ResourceType() string {
+	return "azurerm_storage_tables"
+}
+
+func (r storageTablesDataSource) ModelObject() interface{} {
+	return &storageTablesDataSourceModel{}
+}
+
+func (r storageTablesDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			var plan storageTablesDataSourceModel
+			if err := metadata.Decode(&plan); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			id, err := commonids.ParseStorageAccountID(plan.StorageAccountId)
+			if err != nil {
+				return err
+			}
+
+			account, err := storageClient.FindAccount(ctx, id.StorageAccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if account == nil {
+				return fmt.Errorf("%s was not found", id)
+			}
+
+			client, err := storageClient.TablesClient(ctx, *account)
+			if err != nil {
+				return fmt.Errorf("building Tables Client for %s: %+v", id, err)
+			}
+
+			// an Account without any Tables returns an empty list, whereas any other error (such as an
+			// authorization failure) is surfaced, so that this can't be mistaken for an Account without Tables
+			names, err := client.List(ctx, account.ResourceGroup, id.StorageAccountName)
+			if err != nil {
+				return fmt.Errorf("listing the Tables within %s: %+v", id, err)
+			}
+			plan.Names = pointer.From(names)
+
+			if err := metadata.Encode(&plan); err != nil {
+				return fmt.Errorf("encoding %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
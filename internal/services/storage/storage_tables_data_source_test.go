@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type storageTablesDataSource struct{}
+
+func TestAccDataSourceStorageTables_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_tables", "test")
+	d := storageTablesDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: d.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("names.#").HasValue("2"),
+				check.That(data.ResourceName).Key("names.0").HasValue("test1"),
+				check.That(data.ResourceName).Key("names.1").HasValue("test2"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceStorageTables_empty(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_tables", "test")
+	d := storageTablesDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: d.empty(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("names.#").HasValue("0"),
+			),
+		},
+	})
+}
+
+func (d storageTablesDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
+func (d storageTablesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_table" "test1" {
+  name                 = "test1"
+  storage_account_name = azurerm_storage_account.test.name
+}
+
+resource "azurerm_storage_table" "test2" {
+  name                 = "test2"
+  storage_account_name = azurerm_storage_account.test.name
+}
+
+data "azurerm_storage_tables" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  depends_on         = [azurerm_storage_table.test1, azurerm_storage_table.test2]
+}
+`, d.template(data))
+}
+
+func (d storageTablesDataSource) empty(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_storage_tables" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+}
+`, d.template(data))
+}
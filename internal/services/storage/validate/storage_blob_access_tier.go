@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+)
+
+// StorageBlobAccessTiers are the Access Tiers which can be set on a Blob
+var StorageBlobAccessTiers = []string{
+	"Archive",
+	"Cold",
+	"Cool",
+	"Hot",
+}
+
+// StorageBlobAccessTier validates that the Access Tier for a Blob is one of `Archive`, `Cold`, `Cool` or `Hot`.
+func StorageBlobAccessTier(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	for _, tier := range StorageBlobAccessTiers {
+		if value == tier {
+			return warnings, errors
+		}
+	}
+
+	errors = append(errors, fmt.Errorf("expected %q to be one of %q but got %q", k, StorageBlobAccessTiers, value))
+	return warnings, errors
+}
@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import "testing"
+
+func TestStorageBlobAccessTier(t *testing.T) {
+	testCases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "Hot",
+			Valid: true,
+		},
+		{
+			Input: "Cool",
+			Valid: true,
+		},
+		{
+			Input: "Cold",
+			Valid: true,
+		},
+		{
+			Input: "Archive",
+			Valid: true,
+		},
+		{
+			Input: "cold",
+			Valid: false,
+		},
+		{
+			Input: "Premium",
+			Valid: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("[DEBUG] Testing Value %q", tc.Input)
+		_, errors := StorageBlobAccessTier(tc.Input, "access_tier")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t for %q", tc.Valid, valid, tc.Input)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/blob/blobs"
+)
+
+func StorageBlobDataPlaneID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	id, err := blobs.ParseResourceID(v)
+	if err != nil {
+		errors = append(errors, err)
+		return
+	}
+
+	if id.AccountName == "" || id.ContainerName == "" || id.BlobName == "" {
+		errors = append(errors, fmt.Errorf("expected %q to be in the format `https://{accountName}.blob.{domainSuffix}/{containerName}/{blobName}` but got %q", key, v))
+	}
+
+	return
+}
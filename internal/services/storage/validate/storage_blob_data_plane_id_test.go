@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import "testing"
+
+func TestStorageBlobDataPlaneID(t *testing.T) {
+	testCases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "https://account1.blob.core.windows.net",
+			Valid: false,
+		},
+		{
+			Input: "https://account1.blob.core.windows.net/container1",
+			Valid: false,
+		},
+		{
+			Input: "https://account1.blob.core.windows.net/container1/blob1.vhd",
+			Valid: true,
+		},
+		{
+			Input: "https://account1.blob.core.windows.net/container1/some/nested/blob1.vhd",
+			Valid: true,
+		},
+		{
+			Input: "https://account1.blob.core.chinacloudapi.cn/container1/blob1.vhd",
+			Valid: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		_, errors := StorageBlobDataPlaneID(tc.Input, "test")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
@@ -29,6 +29,27 @@ func StorageContainerName(v interface{}, k string) (warnings []string, errors []
 	return warnings, errors
 }
 
+// StorageContainerLegacyName validates the name of a Storage Container which may have been created prior to the
+// current naming rules - which (unlike StorageContainerName) allows uppercase characters.
+func StorageContainerLegacyName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if !regexp.MustCompile(`^\$root$|^\$web$|^[0-9a-zA-Z-]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"only alphanumeric characters and hyphens allowed in %q: %q",
+			k, value))
+	}
+	if len(value) < 3 || len(value) > 63 {
+		errors = append(errors, fmt.Errorf(
+			"%q must be between 3 and 63 characters: %q", k, value))
+	}
+	if regexp.MustCompile(`^-`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot begin with a hyphen: %q", k, value))
+	}
+	return warnings, errors
+}
+
 func StorageContainerDataPlaneID(input interface{}, key string) (warnings []string, errors []error) {
 	v, ok := input.(string)
 	if !ok {
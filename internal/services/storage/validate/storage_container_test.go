@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStorageContainerName(t *testing.T) {
+	testCases := []struct {
+		Input          string
+		Expected       bool
+		ExpectedLegacy bool
+	}{
+		{
+			Input:          "",
+			Expected:       false,
+			ExpectedLegacy: false,
+		},
+		{
+			Input:          "ab",
+			Expected:       false,
+			ExpectedLegacy: false,
+		},
+		{
+			Input:          "example-container",
+			Expected:       true,
+			ExpectedLegacy: true,
+		},
+		{
+			Input:          "$root",
+			Expected:       true,
+			ExpectedLegacy: true,
+		},
+		{
+			Input:          "$web",
+			Expected:       true,
+			ExpectedLegacy: true,
+		},
+		{
+			Input:          "-example",
+			Expected:       false,
+			ExpectedLegacy: false,
+		},
+		{
+			Input:          "Example-Container",
+			Expected:       false,
+			ExpectedLegacy: true,
+		},
+		{
+			Input:          "example_container",
+			Expected:       false,
+			ExpectedLegacy: false,
+		},
+		{
+			Input:          strings.Repeat("a", 63),
+			Expected:       true,
+			ExpectedLegacy: true,
+		},
+		{
+			Input:          strings.Repeat("a", 64),
+			Expected:       false,
+			ExpectedLegacy: false,
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Test Input %q", v.Input)
+
+		_, errors := StorageContainerName(v.Input, "name")
+		if result := len(errors) == 0; result != v.Expected {
+			t.Fatalf("Expected the result to be %t but got %t (and %d errors)", v.Expected, result, len(errors))
+		}
+
+		_, errors = StorageContainerLegacyName(v.Input, "name")
+		if result := len(errors) == 0; result != v.ExpectedLegacy {
+			t.Fatalf("Expected the legacy result to be %t but got %t (and %d errors)", v.ExpectedLegacy, result, len(errors))
+		}
+	}
+}
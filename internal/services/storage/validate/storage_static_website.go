@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"strings"
+)
+
+// StaticWebsiteIndexDocument validates the name of the Index Document for a Static Website, which is
+// served for requests to the root of the website (and any sub-folder) and so can't contain a path
+func StaticWebsiteIndexDocument(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if strings.TrimSpace(value) == "" {
+		errors = append(errors, fmt.Errorf("%q must not be empty", k))
+		return
+	}
+
+	if strings.Contains(value, "/") {
+		errors = append(errors, fmt.Errorf("%q must be a file name and cannot contain a `/`, got %q", k, value))
+	}
+
+	if len(value) > 255 {
+		errors = append(errors, fmt.Errorf("%q must be at most 255 characters, got %d", k, len(value)))
+	}
+
+	return warnings, errors
+}
+
+// StaticWebsiteErrorDocument validates the path to the 404 Error Document for a Static Website, which
+// is an absolute path within the `$web` container (without a leading `/`)
+func StaticWebsiteErrorDocument(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if strings.TrimSpace(value) == "" {
+		errors = append(errors, fmt.Errorf("%q must not be empty", k))
+		return
+	}
+
+	if strings.HasPrefix(value, "/") || strings.HasSuffix(value, "/") {
+		errors = append(errors, fmt.Errorf("%q must be a path to a file within the `$web` container and cannot start or end with a `/`, got %q", k, value))
+	}
+
+	if len(value) > 1024 {
+		errors = append(errors, fmt.Errorf("%q must be at most 1024 characters, got %d", k, len(value)))
+	}
+
+	return warnings, errors
+}
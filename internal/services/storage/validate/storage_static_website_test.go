@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStaticWebsiteIndexDocument(t *testing.T) {
+	testCases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "index.html",
+			Valid: true,
+		},
+		{
+			Input: "default.htm",
+			Valid: true,
+		},
+		{
+			Input: "pages/index.html",
+			Valid: false,
+		},
+		{
+			Input: strings.Repeat("a", 256),
+			Valid: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("[DEBUG] Testing Value %q", tc.Input)
+		_, errors := StaticWebsiteIndexDocument(tc.Input, "index_document")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t for %q", tc.Valid, valid, tc.Input)
+		}
+	}
+}
+
+func TestStaticWebsiteErrorDocument(t *testing.T) {
+	testCases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "404.html",
+			Valid: true,
+		},
+		{
+			Input: "errors/404.html",
+			Valid: true,
+		},
+		{
+			Input: "/errors/404.html",
+			Valid: false,
+		},
+		{
+			Input: "errors/",
+			Valid: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("[DEBUG] Testing Value %q", tc.Input)
+		_, errors := StaticWebsiteErrorDocument(tc.Input, "error_404_document")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t for %q", tc.Valid, valid, tc.Input)
+		}
+	}
+}
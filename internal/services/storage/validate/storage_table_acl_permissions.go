@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"strings"
+)
+
+// StorageTableACLPermissions validates that the permissions for a Table ACL only contain the
+// characters supported by the Table Service (`r`, `a`, `u` and `d`) and that each is specified once.
+func StorageTableACLPermissions(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if value == "" {
+		errors = append(errors, fmt.Errorf("%q must not be empty", k))
+		return
+	}
+
+	seen := make(map[rune]struct{})
+	for _, c := range value {
+		if !strings.ContainsRune("raud", c) {
+			errors = append(errors, fmt.Errorf("%q can only contain the characters `r`, `a`, `u` and `d` but got %q", k, value))
+			return
+		}
+		if _, exists := seen[c]; exists {
+			errors = append(errors, fmt.Errorf("%q contains the permission %q more than once: %q", k, string(c), value))
+			return
+		}
+		seen[c] = struct{}{}
+	}
+
+	return warnings, errors
+}
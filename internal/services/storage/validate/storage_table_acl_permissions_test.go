@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import "testing"
+
+func TestStorageTableACLPermissions(t *testing.T) {
+	testCases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "r",
+			Valid: true,
+		},
+		{
+			Input: "raud",
+			Valid: true,
+		},
+		{
+			Input: "dar",
+			Valid: true,
+		},
+		{
+			Input: "rr",
+			Valid: false,
+		},
+		{
+			Input: "rw",
+			Valid: false,
+		},
+		{
+			Input: "RAUD",
+			Valid: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("[DEBUG] Testing Value %q", tc.Input)
+		_, errors := StorageTableACLPermissions(tc.Input, "permissions")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t for %q", tc.Valid, valid, tc.Input)
+		}
+	}
+}
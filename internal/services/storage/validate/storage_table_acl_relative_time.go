@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"time"
+)
+
+// StorageTableACLRelativeTime validates that the relative start/expiry time for a Table ACL is a valid
+// duration (e.g. `30m` or `24h`), which is resolved relative to the time the ACL is applied.
+func StorageTableACLRelativeTime(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if value == "" {
+		errors = append(errors, fmt.Errorf("%q must not be empty", k))
+		return
+	}
+
+	if _, err := time.ParseDuration(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a duration such as `30m` or `24h` but got %q: %+v", k, value, err))
+	}
+
+	return warnings, errors
+}
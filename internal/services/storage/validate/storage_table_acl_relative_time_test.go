@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import "testing"
+
+func TestStorageTableACLRelativeTime(t *testing.T) {
+	testCases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "24",
+			Valid: false,
+		},
+		{
+			Input: "1d",
+			Valid: false,
+		},
+		{
+			Input: "0s",
+			Valid: true,
+		},
+		{
+			Input: "30m",
+			Valid: true,
+		},
+		{
+			Input: "720h",
+			Valid: true,
+		},
+		{
+			Input: "-15m",
+			Valid: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("[DEBUG] Testing Value %q", tc.Input)
+		_, errors := StorageTableACLRelativeTime(tc.Input, "start_in")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t for %q", tc.Valid, valid, tc.Input)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// storageTableEntityETagRegex matches a (weak or strong) ETag, e.g. `W/"datetime'2024-01-02T03%3A04%3A05.1234567Z'"`
+var storageTableEntityETagRegex = regexp.MustCompile(`^(W/)?"[^"\s]+"$`)
+
+// StorageTableEntityETag validates that the value is an ETag for a Storage Table Entity, which (as returned in the
+// `ETag` header or the `odata.etag` property) is a quoted string - the wildcard `*` isn't accepted, since this
+// matches any version of the Entity.
+func StorageTableEntityETag(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if !storageTableEntityETagRegex.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be an ETag such as `W/\"datetime'2024-01-02T03%%3A04%%3A05.1234567Z'\"` (including the quotes) but got %q", k, value))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import "testing"
+
+func TestStorageTableEntityETag(t *testing.T) {
+	testCases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "*",
+			Valid: false,
+		},
+		{
+			Input: "datetime'2024-01-02T03%3A04%3A05.1234567Z'",
+			Valid: false,
+		},
+		{
+			Input: `W/"datetime'2024-01-02T03%3A04%3A05.1234567Z'`,
+			Valid: false,
+		},
+		{
+			Input: `W/"datetime'2024-01-02T03:04:05 Z'"`,
+			Valid: false,
+		},
+		{
+			Input: `W/"datetime'2024-01-02T03%3A04%3A05.1234567Z'"`,
+			Valid: true,
+		},
+		{
+			Input: `"0x8D9A1B2C3D4E5F6"`,
+			Valid: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("[DEBUG] Testing Value %q", tc.Input)
+		_, errors := StorageTableEntityETag(tc.Input, "if_match_etag")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t for %q", tc.Valid, valid, tc.Input)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"strings"
+)
+
+// storageTableEntityKeyMaxLength is the maximum size (in bytes) of a Partition Key or Row Key
+const storageTableEntityKeyMaxLength = 1024
+
+// StorageTableEntityKey validates a Partition Key or Row Key for a Storage Table Entity, which must be at most 1KiB
+// and can't contain `/`, `\`, `#`, `?` or control characters
+func StorageTableEntityKey(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if value == "" {
+		errors = append(errors, fmt.Errorf("%q cannot be an empty string", k))
+		return warnings, errors
+	}
+
+	if len(value) > storageTableEntityKeyMaxLength {
+		errors = append(errors, fmt.Errorf("%q can be at most %d bytes but got %d bytes", k, storageTableEntityKeyMaxLength, len(value)))
+	}
+
+	if strings.ContainsAny(value, `/\#?`) {
+		errors = append(errors, fmt.Errorf("%q cannot contain the characters `/`, `\\`, `#` or `?`: %q", k, value))
+	}
+
+	for _, r := range value {
+		if r <= 0x1F || (r >= 0x7F && r <= 0x9F) {
+			errors = append(errors, fmt.Errorf("%q cannot contain control characters: %q", k, value))
+			break
+		}
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStorageTableEntityKey(t *testing.T) {
+	testCases := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			Input:    "",
+			Expected: false,
+		},
+		{
+			Input:    "partition1",
+			Expected: true,
+		},
+		{
+			Input:    "with spaces-and_symbols.!",
+			Expected: true,
+		},
+		{
+			Input:    "ünïcödé",
+			Expected: true,
+		},
+		{
+			Input:    strings.Repeat("a", 1024),
+			Expected: true,
+		},
+		{
+			Input:    strings.Repeat("a", 1025),
+			Expected: false,
+		},
+		{
+			// 513 characters, but 1026 bytes
+			Input:    strings.Repeat("ü", 513),
+			Expected: false,
+		},
+		{
+			Input:    "forward/slash",
+			Expected: false,
+		},
+		{
+			Input:    `back\slash`,
+			Expected: false,
+		},
+		{
+			Input:    "hash#",
+			Expected: false,
+		},
+		{
+			Input:    "question?",
+			Expected: false,
+		},
+		{
+			Input:    "tab\there",
+			Expected: false,
+		},
+		{
+			Input:    "delete\u007f",
+			Expected: false,
+		},
+		{
+			Input:    "c1\u0085control",
+			Expected: false,
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Test Input %q", v.Input)
+
+		_, errors := StorageTableEntityKey(v.Input, "partition_key")
+		if result := len(errors) == 0; result != v.Expected {
+			t.Fatalf("Expected the result to be %t but got %t (and %d errors)", v.Expected, result, len(errors))
+		}
+	}
+}
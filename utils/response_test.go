@@ -18,6 +18,13 @@ func TestResponseNotFound_DroppedConnection(t *testing.T) {
 	}
 }
 
+func TestResponseConflict_DroppedConnection(t *testing.T) {
+	resp := autorest.Response{}
+	if ResponseWasConflict(resp) {
+		t.Fatalf("responseWasConflict should return `false` for a dropped connection")
+	}
+}
+
 func TestResponseNotFound_StatusCodes(t *testing.T) {
 	testCases := []struct {
 		statusCode     int